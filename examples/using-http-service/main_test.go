@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"gofr.dev/pkg/gofr"
 	"gofr.dev/pkg/gofr/container"
@@ -74,7 +75,10 @@ func TestHTTPHandlerURLError(t *testing.T) {
 	ctx := &gofr.Context{Context: context.Background(),
 		Request: gofrReq, Container: &container.Container{Logger: logger}}
 
-	ctx.Container.Services = map[string]service.HTTP{"cat-facts": service.NewHTTPService("http://invalid", ctx.Logger, nil)}
+	svc, err := service.NewHTTPService("http://invalid", ctx.Logger, nil)
+	require.NoError(t, err)
+
+	ctx.Container.Services = map[string]service.HTTP{"cat-facts": svc}
 
 	resp, err := Handler(ctx)
 
@@ -99,7 +103,10 @@ func TestHTTPHandlerResponseUnmarshalError(t *testing.T) {
 	ctx := &gofr.Context{Context: context.Background(),
 		Request: gofrReq, Container: &container.Container{Logger: logger}}
 
-	ctx.Container.Services = map[string]service.HTTP{"cat-facts": service.NewHTTPService(server.URL, ctx.Logger, nil)}
+	svc, err := service.NewHTTPService(server.URL, ctx.Logger, nil)
+	require.NoError(t, err)
+
+	ctx.Container.Services = map[string]service.HTTP{"cat-facts": svc}
 
 	resp, err := Handler(ctx)
 
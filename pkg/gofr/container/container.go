@@ -63,7 +63,7 @@ func (c *Container) Create(conf config.Config) {
 
 	if c.Logger == nil {
 		c.Logger = logging.NewRemoteLogger(logging.GetLevelFromString(conf.Get("LOG_LEVEL")), conf.Get("REMOTE_LOG_URL"),
-			conf.GetOrDefault("REMOTE_LOG_FETCH_INTERVAL", "15"))
+			conf.GetOrDefault("REMOTE_LOG_FETCH_INTERVAL", "15"), logJSONConfig(conf))
 	}
 
 	c.Debug("Container is being created")
@@ -125,12 +125,38 @@ func (c *Container) Create(conf config.Config) {
 	}
 }
 
+// logJSONConfig reads how log entries are serialized as JSON from the LOG_DISABLE_HTML_ESCAPE, LOG_DURATION_AS_STRING
+// and LOG_UNSERIALIZABLE_PLACEHOLDER configs, which are all disabled by default.
+func logJSONConfig(conf config.Config) *logging.JSONConfig {
+	disableHTMLEscape, _ := strconv.ParseBool(conf.GetOrDefault("LOG_DISABLE_HTML_ESCAPE", "false"))
+	durationAsString, _ := strconv.ParseBool(conf.GetOrDefault("LOG_DURATION_AS_STRING", "false"))
+	unserializablePlaceholder, _ := strconv.ParseBool(conf.GetOrDefault("LOG_UNSERIALIZABLE_PLACEHOLDER", "false"))
+
+	return &logging.JSONConfig{
+		DisableHTMLEscape:         disableHTMLEscape,
+		DurationAsString:          durationAsString,
+		UnserializablePlaceholder: unserializablePlaceholder,
+	}
+}
+
 // GetHTTPService returns registered http services.
 // HTTP services are registered from AddHTTPService method of gofr object.
 func (c *Container) GetHTTPService(serviceName string) service.HTTP {
 	return c.Services[serviceName]
 }
 
+// Close stops the background goroutines of the container, i.e. the health checks of the circuit breakers of the
+// HTTP services and the remote log level updates. The services and the logger remain usable.
+func (c *Container) Close() {
+	for _, svc := range c.Services {
+		service.Close(svc)
+	}
+
+	if l, ok := c.Logger.(interface{ Close() }); ok {
+		l.Close()
+	}
+}
+
 func (c *Container) Metrics() metrics.Manager {
 	return c.metricsManager
 }
@@ -2,14 +2,19 @@ package container
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"gofr.dev/pkg/gofr/config"
 	"gofr.dev/pkg/gofr/datasource"
 	"gofr.dev/pkg/gofr/datasource/pubsub"
 	"gofr.dev/pkg/gofr/datasource/pubsub/mqtt"
+	"gofr.dev/pkg/gofr/logging"
 	"gofr.dev/pkg/gofr/service"
 	"gofr.dev/pkg/gofr/testutil"
 )
@@ -22,6 +27,51 @@ func Test_newContainerSuccessWithLogger(t *testing.T) {
 	assert.NotNil(t, container.Logger, "TEST, Failed.\nlogger initialisation")
 }
 
+func Test_newContainerLogJSONConfig(t *testing.T) {
+	t.Setenv("LOG_DISABLE_HTML_ESCAPE", "true")
+	t.Setenv("LOG_DURATION_AS_STRING", "true")
+
+	logs := testutil.StdoutOutputForFunc(func() {
+		container := NewContainer(config.NewEnvFile(""))
+
+		container.Info("https://gofr.dev/docs?page=1&size=10")
+		container.Info(1500 * time.Millisecond)
+	})
+
+	assert.Contains(t, logs, `"message":"https://gofr.dev/docs?page=1&size=10"`)
+	assert.Contains(t, logs, `"message":"1.5s"`)
+}
+
+func TestContainer_Close(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	t.Setenv("REMOTE_LOG_URL", server.URL)
+
+	serviceGoroutines, loggingGoroutines := service.BackgroundGoroutines(), logging.BackgroundGoroutines()
+
+	c := NewContainer(config.NewEnvFile(""))
+
+	svc, err := service.NewHTTPService(server.URL, c.Logger, nil,
+		&service.CircuitBreakerConfig{Threshold: 1, Interval: time.Hour}, &service.APIKeyConfig{APIKey: "key"})
+	require.NoError(t, err)
+
+	c.Services = map[string]service.HTTP{"test-service": svc}
+
+	assert.Equal(t, serviceGoroutines+1, service.BackgroundGoroutines())
+	assert.Equal(t, loggingGoroutines+1, logging.BackgroundGoroutines())
+
+	// the health checks of a circuit breaker wrapped by another option are stopped as well
+	c.Close()
+
+	assert.True(t, testutil.WaitForGoroutines(service.BackgroundGoroutines, serviceGoroutines, 3*time.Second),
+		"circuit breaker health checks leaked")
+	assert.True(t, testutil.WaitForGoroutines(logging.BackgroundGoroutines, loggingGoroutines, 3*time.Second),
+		"remote log level updates leaked")
+}
+
 func Test_newContainerDBIntializationFail(t *testing.T) {
 	t.Setenv("REDIS_HOST", "invalid")
 	t.Setenv("DB_DIALECT", "mysql")
@@ -78,7 +128,8 @@ func TestContianer_MQTTInitialization_Default(t *testing.T) {
 }
 
 func TestContainer_GetHTTPService(t *testing.T) {
-	svc := service.NewHTTPService("", nil, nil)
+	svc, err := service.NewHTTPService("http://localhost", nil, nil)
+	require.NoError(t, err)
 
 	c := &Container{Services: map[string]service.HTTP{
 		"test-service": svc,
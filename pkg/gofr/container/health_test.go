@@ -9,6 +9,7 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"gofr.dev/pkg/gofr/datasource"
 	"gofr.dev/pkg/gofr/datasource/sql"
@@ -72,7 +73,10 @@ func TestContainer_Health(t *testing.T) {
 	}))
 
 	c.Services = make(map[string]service.HTTP)
-	c.Services["test-service"] = service.NewHTTPService(srv.URL, logger, nil)
+	svc, err := service.NewHTTPService(srv.URL, logger, nil)
+	require.NoError(t, err)
+
+	c.Services["test-service"] = svc
 
 	c.SQL.DB = mockDB
 
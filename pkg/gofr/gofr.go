@@ -110,6 +110,9 @@ func (a *App) Run() {
 		a.cmd.Run(a.container)
 	}
 
+	// closed once the application has shut down gracefully, nil when no circuit breaker opted in to draining
+	stopped := a.handleShutdown()
+
 	wg := sync.WaitGroup{}
 
 	// Start Metrics Server
@@ -158,6 +161,11 @@ func (a *App) Run() {
 		}
 
 		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			<-stopped
+		}()
 	}
 
 	wg.Wait()
@@ -173,7 +181,7 @@ func (a *App) readConfig() {
 	a.Config = config.NewEnvFile(configLocation)
 }
 
-// AddHTTPService registers HTTP service in container.
+// AddHTTPService registers HTTP service in container. The application exits if the service address is invalid.
 func (a *App) AddHTTPService(serviceName, serviceAddress string, options ...service.Options) {
 	if a.container.Services == nil {
 		a.container.Services = make(map[string]service.HTTP)
@@ -183,7 +191,13 @@ func (a *App) AddHTTPService(serviceName, serviceAddress string, options ...serv
 		a.container.Debugf("Service already registered Name: %v", serviceName)
 	}
 
-	a.container.Services[serviceName] = service.NewHTTPService(serviceAddress, a.container.Logger, a.container.Metrics(), options...)
+	svc, err := service.NewHTTPService(serviceAddress, a.container.Logger, a.container.Metrics(), options...)
+	if err != nil {
+		// an unregistered service would only fail once a handler calls it, so the application fails at startup instead
+		a.container.Fatalf("could not register service %v: %v", serviceName, err)
+	}
+
+	a.container.Services[serviceName] = svc
 }
 
 // GET adds a Handler for http GET method for a route pattern.
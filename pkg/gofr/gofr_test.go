@@ -1,17 +1,21 @@
 package gofr
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"os/exec"
 	"strconv"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"gofr.dev/pkg/gofr/container"
 	gofrHTTP "gofr.dev/pkg/gofr/http"
@@ -154,6 +158,33 @@ func Test_AddHTTPService(t *testing.T) {
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 }
 
+func Test_AddHTTPServiceInvalidAddress(t *testing.T) {
+	// the application exits when the address is invalid, so it is registered in a separate process
+	if os.Getenv("GOFR_TEST_INVALID_SERVICE_ADDRESS") == "1" {
+		New().AddHTTPService("test-service", "localhost:8080")
+
+		return
+	}
+
+	executable, err := os.Executable()
+	require.NoError(t, err)
+
+	cmd := exec.Command(executable, "-test.run=^Test_AddHTTPServiceInvalidAddress$") //nolint:gosec // test binary
+	cmd.Env = append(os.Environ(), "GOFR_TEST_INVALID_SERVICE_ADDRESS=1")
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	err = cmd.Run()
+
+	var exitErr *exec.ExitError
+
+	require.ErrorAs(t, err, &exitErr)
+	assert.Equal(t, 1, exitErr.ExitCode())
+	assert.Contains(t, stderr.String(), "could not register service test-service")
+	assert.Contains(t, stderr.String(), `"level":"FATAL"`)
+}
+
 func Test_AddDuplicateHTTPService(t *testing.T) {
 	t.Setenv("LOG_LEVEL", "DEBUG")
 
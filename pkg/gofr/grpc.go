@@ -1,6 +1,7 @@
 package gofr
 
 import (
+	"context"
 	"net"
 	"strconv"
 
@@ -44,3 +45,19 @@ func (g *grpcServer) Run(c *container.Container) {
 		return
 	}
 }
+
+// Shutdown stops the server gracefully, waiting for pending RPCs to complete until ctx is done.
+func (g *grpcServer) Shutdown(ctx context.Context) {
+	stopped := make(chan struct{})
+
+	go func() {
+		g.server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		g.server.Stop()
+	}
+}
@@ -1,8 +1,11 @@
 package gofr
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
 	"gofr.dev/pkg/gofr/container"
@@ -12,6 +15,9 @@ import (
 type httpServer struct {
 	router *gofrHTTP.Router
 	port   int
+
+	mu  sync.Mutex
+	srv *http.Server
 }
 
 func newHTTPServer(c *container.Container, port int) *httpServer {
@@ -21,16 +27,32 @@ func newHTTPServer(c *container.Container, port int) *httpServer {
 	}
 }
 
-func (s *httpServer) Run(c *container.Container) {
-	var srv *http.Server
+// server returns the underlying http.Server, creating it on first use so that Shutdown can be called before Run.
+func (s *httpServer) server() *http.Server {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.srv == nil {
+		s.srv = &http.Server{
+			Addr:              fmt.Sprintf(":%d", s.port),
+			Handler:           s.router,
+			ReadHeaderTimeout: 5 * time.Second,
+		}
+	}
 
+	return s.srv
+}
+
+func (s *httpServer) Run(c *container.Container) {
 	c.Logf("Starting server on port: %d", s.port)
 
-	srv = &http.Server{
-		Addr:              fmt.Sprintf(":%d", s.port),
-		Handler:           s.router,
-		ReadHeaderTimeout: 5 * time.Second,
+	err := s.server().ListenAndServe()
+	if !errors.Is(err, http.ErrServerClosed) {
+		c.Error(err)
 	}
+}
 
-	c.Error(srv.ListenAndServe())
+// Shutdown stops the server from accepting new connections and waits for in-flight requests to complete.
+func (s *httpServer) Shutdown(ctx context.Context) error {
+	return s.server().Shutdown(ctx)
 }
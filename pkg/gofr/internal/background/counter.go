@@ -0,0 +1,26 @@
+// Package background counts the goroutines that packages spawn in the background, so that they can expose how many
+// are still running and tests can verify that background tasks exit when expected and do not leak.
+package background
+
+import "sync/atomic"
+
+// Counter counts the goroutines it started that have not exited yet.
+type Counter struct {
+	active atomic.Int64
+}
+
+// Go runs f in a new goroutine tracked by the counter.
+func (c *Counter) Go(f func()) {
+	c.active.Add(1)
+
+	go func() {
+		defer c.active.Add(-1)
+
+		f()
+	}()
+}
+
+// Active returns the number of goroutines started by the counter that are still running.
+func (c *Counter) Active() int64 {
+	return c.active.Load()
+}
@@ -0,0 +1,25 @@
+package background
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"gofr.dev/pkg/gofr/testutil"
+)
+
+func TestCounter(t *testing.T) {
+	var c Counter
+
+	done := make(chan struct{})
+
+	c.Go(func() { <-done })
+	c.Go(func() { <-done })
+
+	assert.Equal(t, int64(2), c.Active())
+
+	close(done)
+
+	assert.True(t, testutil.WaitForGoroutines(c.Active, 0, time.Second), "background goroutines leaked")
+}
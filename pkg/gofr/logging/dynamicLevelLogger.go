@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"io"
 	"strconv"
+	"sync"
 	"time"
 
+	"gofr.dev/pkg/gofr/internal/background"
 	"gofr.dev/pkg/gofr/service"
 )
 
@@ -14,21 +16,31 @@ const (
 	requestTimeout = 5 * time.Second
 )
 
-func NewRemoteLogger(level Level, remoteConfigURL, loggerFetchInterval string) Logger {
+// goroutines tracks the goroutines spawned by the package, such as remote log level updates.
+var goroutines background.Counter
+
+// BackgroundGoroutines returns the number of goroutines spawned by the package that are still running. It allows
+// tests to verify that background tasks exit when expected and do not leak.
+func BackgroundGoroutines() int64 {
+	return goroutines.Active()
+}
+
+func NewRemoteLogger(level Level, remoteConfigURL, loggerFetchInterval string, options ...Options) Logger {
 	interval, err := strconv.Atoi(loggerFetchInterval)
 	if err != nil {
 		interval = 15
 	}
 
-	l := remoteLogger{
+	l := &remoteLogger{
 		remoteURL:          remoteConfigURL,
-		Logger:             NewLogger(level),
+		Logger:             NewLogger(level, options...),
 		levelFetchInterval: interval,
 		currentLevel:       level,
+		stop:               make(chan struct{}),
 	}
 
 	if remoteConfigURL != "" {
-		go l.UpdateLogLevel()
+		goroutines.Go(l.UpdateLogLevel)
 	}
 
 	return l
@@ -38,25 +50,44 @@ type remoteLogger struct {
 	remoteURL          string
 	levelFetchInterval int
 	currentLevel       Level
+	stop               chan struct{} // stop ends the remote log level updates
+	stopOnce           sync.Once
 	Logger
 }
 
+// Close stops the remote log level updates and the goroutine running them, the logger keeps its current level.
+func (r *remoteLogger) Close() {
+	r.stopOnce.Do(func() {
+		close(r.stop)
+	})
+}
+
 func (r *remoteLogger) UpdateLogLevel() {
 	interval := time.Duration(r.levelFetchInterval) * time.Second
 	ticker := time.NewTicker(interval)
 
 	defer ticker.Stop()
 
-	remoteService := service.NewHTTPService(r.remoteURL, r.Logger, nil)
+	remoteService, err := service.NewHTTPService(r.remoteURL, r.Logger, nil)
+	if err != nil {
+		r.Errorf("remote log level updates are disabled: %v", err)
 
-	for range ticker.C {
-		newLevel, err := fetchAndUpdateLogLevel(remoteService, r.currentLevel)
-		if err == nil {
-			r.changeLevel(newLevel)
+		return
+	}
 
-			if r.currentLevel != newLevel {
-				r.Infof("LOG_LEVEL updated from %v to %v", r.currentLevel, newLevel)
-				r.currentLevel = newLevel
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+			newLevel, err := fetchAndUpdateLogLevel(remoteService, r.currentLevel)
+			if err == nil {
+				r.changeLevel(newLevel)
+
+				if r.currentLevel != newLevel {
+					r.Infof("LOG_LEVEL updated from %v to %v", r.currentLevel, newLevel)
+					r.currentLevel = newLevel
+				}
 			}
 		}
 	}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"gofr.dev/pkg/gofr/service"
 	"gofr.dev/pkg/gofr/testutil"
@@ -54,7 +55,11 @@ func TestDynamicLoggerSuccess(t *testing.T) {
 func Test_fetchAndUpdateLogLevel_ErrorCases(t *testing.T) {
 	logger := testutil.NewMockLogger(testutil.INFOLOG)
 
-	remoteService := service.NewHTTPService("http://", logger, nil)
+	closedServer := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	closedServer.Close()
+
+	remoteService, err := service.NewHTTPService(closedServer.URL, logger, nil)
+	require.NoError(t, err)
 
 	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -70,14 +75,15 @@ func Test_fetchAndUpdateLogLevel_ErrorCases(t *testing.T) {
 	}))
 	defer mockServer.Close()
 
-	remoteService2 := service.NewHTTPService(mockServer.URL, logger, nil)
+	remoteService2, err := service.NewHTTPService(mockServer.URL, logger, nil)
+	require.NoError(t, err)
 
 	tests := []struct {
 		desc            string
 		remoteService   service.HTTP
 		currentLogLevel Level
 	}{
-		{"invalid URL for remote service", remoteService, testutil.INFOLOG},
+		{"unreachable remote service", remoteService, testutil.INFOLOG},
 		{"invalid response from remote service", remoteService2, testutil.DEBUGLOG},
 	}
 
@@ -89,3 +95,37 @@ func Test_fetchAndUpdateLogLevel_ErrorCases(t *testing.T) {
 		assert.NotNil(t, err)
 	}
 }
+
+func TestRemoteLogger_InvalidURLStopsBackgroundGoroutine(t *testing.T) {
+	before := BackgroundGoroutines()
+
+	var exited bool
+
+	log := testutil.StderrOutputForFunc(func() {
+		_ = NewRemoteLogger(INFO, "localhost:8080", "1")
+
+		exited = testutil.WaitForGoroutines(BackgroundGoroutines, before, 3*time.Second)
+	})
+
+	assert.True(t, exited, "remote log level goroutine leaked")
+	assert.Contains(t, log, "remote log level updates are disabled")
+}
+
+func TestRemoteLogger_Close(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	before := BackgroundGoroutines()
+
+	logger := NewRemoteLogger(INFO, mockServer.URL, "1")
+
+	assert.Equal(t, before+1, BackgroundGoroutines())
+
+	logger.(*remoteLogger).Close()
+	logger.(*remoteLogger).Close()
+
+	assert.True(t, testutil.WaitForGoroutines(BackgroundGoroutines, before, 3*time.Second),
+		"remote log level goroutine leaked")
+}
@@ -1,7 +1,6 @@
 package logging
 
 import (
-	"encoding/json"
 	"fmt"
 	"io"
 	"math"
@@ -27,6 +26,12 @@ type Logger interface {
 	Logf(format string, args ...interface{})
 	Info(args ...interface{})
 	Infof(format string, args ...interface{})
+	// InfoEvery logs the formatted message at INFO level at most once per interval. Messages are keyed by format,
+	// not by call site, so all calls sharing a format are rate-limited together.
+	InfoEvery(interval time.Duration, format string, args ...interface{})
+	// InfoEveryKey is like InfoEvery, but all messages sharing key are rate-limited together. Keys never share a
+	// rate limit with the formats of InfoEvery.
+	InfoEveryKey(key string, interval time.Duration, format string, args ...interface{})
 	Notice(args ...interface{})
 	Noticef(format string, args ...interface{})
 	Warn(args ...interface{})
@@ -43,6 +48,8 @@ type logger struct {
 	normalOut  io.Writer
 	errorOut   io.Writer
 	isTerminal bool
+	limiter    rateLimiter
+	jsonConfig JSONConfig
 }
 
 type logEntry struct {
@@ -80,7 +87,7 @@ func (l *logger) logf(level Level, format string, args ...interface{}) {
 	if l.isTerminal {
 		l.prettyPrint(entry, out)
 	} else {
-		_ = json.NewEncoder(out).Encode(entry)
+		l.encodeJSON(entry, out)
 	}
 }
 
@@ -219,7 +226,7 @@ func colorForGRPCCode(status int32) int {
 	return red
 }
 
-func NewLogger(level Level) Logger {
+func NewLogger(level Level, options ...Options) Logger {
 	l := &logger{
 		normalOut: os.Stdout,
 		errorOut:  os.Stderr,
@@ -227,6 +234,10 @@ func NewLogger(level Level) Logger {
 
 	l.level = level
 
+	for _, o := range options {
+		o.addOption(l)
+	}
+
 	l.isTerminal = checkIfTerminal(l.normalOut)
 
 	return l
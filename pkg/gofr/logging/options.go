@@ -0,0 +1,71 @@
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+type Options interface {
+	addOption(l *logger)
+}
+
+// JSONConfig configures how log entries are serialized when logs are written as JSON. The zero value keeps the
+// defaults of encoding/json: HTML characters are escaped, durations are written in nanoseconds and entries whose
+// message cannot be serialized are skipped.
+type JSONConfig struct {
+	// DisableHTMLEscape writes <, > and & as-is instead of escaping them, which keeps URLs in logs readable.
+	DisableHTMLEscape bool
+	// DurationAsString writes time.Duration messages and arguments as strings, e.g. "1.5s", instead of nanoseconds.
+	DurationAsString bool
+	// UnserializablePlaceholder writes entries whose message cannot be serialized with a placeholder message
+	// describing the error, instead of skipping them.
+	UnserializablePlaceholder bool
+}
+
+func (c *JSONConfig) addOption(l *logger) {
+	l.jsonConfig = *c
+}
+
+// encodeJSON writes the entry as a single line of JSON according to the logger's JSONConfig.
+func (l *logger) encodeJSON(e logEntry, out io.Writer) {
+	if l.jsonConfig.DurationAsString {
+		e.Message = durationsAsString(e.Message)
+	}
+
+	enc := json.NewEncoder(out)
+	enc.SetEscapeHTML(!l.jsonConfig.DisableHTMLEscape)
+
+	// the entry is marshaled completely before it is written, so nothing is written when encoding fails
+	err := enc.Encode(e)
+	if err != nil && l.jsonConfig.UnserializablePlaceholder {
+		e.Message = fmt.Sprintf("unserializable message of type %T: %v", e.Message, err)
+
+		_ = enc.Encode(e)
+	}
+}
+
+// durationsAsString replaces time.Duration values in the message, or in its arguments, with their string form.
+func durationsAsString(msg interface{}) interface{} {
+	switch v := msg.(type) {
+	case time.Duration:
+		return v.String()
+	case []interface{}:
+		args := make([]interface{}, len(v))
+		for i := range v {
+			args[i] = durationsAsString(v[i])
+		}
+
+		return args
+	case map[string]interface{}:
+		fields := make(map[string]interface{}, len(v))
+		for k := range v {
+			fields[k] = durationsAsString(v[k])
+		}
+
+		return fields
+	default:
+		return msg
+	}
+}
@@ -0,0 +1,51 @@
+package logging
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"gofr.dev/pkg/gofr/testutil"
+)
+
+func TestLogger_JSONConfig(t *testing.T) {
+	tests := []struct {
+		desc    string
+		config  JSONConfig
+		message interface{}
+		expLog  string
+	}{
+		{"html is escaped by default", JSONConfig{}, "http://a.com?x=1&y=2",
+			`"message":"http://a.com?x=1\u0026y=2"`},
+		{"html escaping disabled", JSONConfig{DisableHTMLEscape: true}, "http://a.com?x=1&y=2",
+			`"message":"http://a.com?x=1&y=2"`},
+		{"duration in nanoseconds by default", JSONConfig{}, 1500 * time.Millisecond,
+			`"message":1500000000`},
+		{"duration as string", JSONConfig{DurationAsString: true}, 1500 * time.Millisecond,
+			`"message":"1.5s"`},
+		{"duration argument as string", JSONConfig{DurationAsString: true}, []interface{}{"took", time.Second},
+			`"message":["took","1s"]`},
+		{"unserializable message is skipped by default", JSONConfig{}, make(chan int), ""},
+		{"unserializable message placeholder", JSONConfig{UnserializablePlaceholder: true}, make(chan int),
+			`"message":"unserializable message of type chan int: json: unsupported type: chan int"`},
+	}
+
+	for i, tc := range tests {
+		config := tc.config
+
+		log := testutil.StdoutOutputForFunc(func() {
+			logger := NewLogger(INFO, &config)
+
+			logger.Info(tc.message)
+		})
+
+		if tc.expLog == "" {
+			assert.Empty(t, log, "TEST[%d], Failed.\n%s", i, tc.desc)
+
+			continue
+		}
+
+		assert.Contains(t, log, tc.expLog, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
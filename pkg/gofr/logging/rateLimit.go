@@ -0,0 +1,97 @@
+package logging
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// pruneInterval is the minimum time between two sweeps of the expired buckets.
+const pruneInterval = time.Minute
+
+// rateLimiter keeps one token bucket per key. Each bucket holds a single token which is refilled once per interval,
+// so a key is allowed at most once per interval.
+type rateLimiter struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	pruneAt time.Time
+}
+
+type bucket struct {
+	refillAt   time.Time // refillAt is the time at which the token becomes available again
+	suppressed int       // suppressed counts the messages dropped since the token was last taken
+}
+
+// allow takes the token for key if it is available and returns the number of messages suppressed since it was last
+// taken. ok is false when the token is not available, in which case the message is counted as suppressed.
+func (r *rateLimiter) allow(key string, interval time.Duration, now time.Time) (suppressed int, ok bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.buckets == nil {
+		r.buckets = make(map[string]*bucket)
+	}
+
+	r.prune(now)
+
+	b, exists := r.buckets[key]
+	if !exists {
+		b = &bucket{}
+		r.buckets[key] = b
+	}
+
+	if now.Before(b.refillAt) {
+		b.suppressed++
+
+		return 0, false
+	}
+
+	suppressed, b.suppressed = b.suppressed, 0
+	b.refillAt = now.Add(interval)
+
+	return suppressed, true
+}
+
+func (l *logger) InfoEvery(interval time.Duration, format string, args ...interface{}) {
+	l.infoEvery("fmt:"+format, interval, format, args...)
+}
+
+func (l *logger) InfoEveryKey(key string, interval time.Duration, format string, args ...interface{}) {
+	l.infoEvery("key:"+key, interval, format, args...)
+}
+
+// infoEvery rate-limits the message by bucket, which is prefixed by its kind so that an explicit key cannot share
+// the bucket of a format.
+func (l *logger) infoEvery(bucket string, interval time.Duration, format string, args ...interface{}) {
+	if INFO < l.level {
+		return
+	}
+
+	suppressed, ok := l.limiter.allow(bucket, interval, time.Now())
+	if !ok {
+		return
+	}
+
+	msg := fmt.Sprintf(format, args...)
+	if suppressed > 0 {
+		msg = fmt.Sprintf("%s (suppressed %d similar messages)", msg, suppressed)
+	}
+
+	l.logf(INFO, "", msg)
+}
+
+// prune removes the buckets whose token is available again and which have no suppressed messages to report, as they
+// behave like a missing bucket. Keys built from variable data would otherwise grow the map without bounds.
+func (r *rateLimiter) prune(now time.Time) {
+	if now.Before(r.pruneAt) {
+		return
+	}
+
+	for key, b := range r.buckets {
+		if !now.Before(b.refillAt) && b.suppressed == 0 {
+			delete(r.buckets, key)
+		}
+	}
+
+	r.pruneAt = now.Add(pruneInterval)
+}
@@ -0,0 +1,99 @@
+package logging
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"gofr.dev/pkg/gofr/testutil"
+)
+
+func TestRateLimiter_Allow(t *testing.T) {
+	var r rateLimiter
+
+	now := time.Now()
+
+	tests := []struct {
+		desc          string
+		key           string
+		at            time.Time
+		expOK         bool
+		expSuppressed int
+	}{
+		{"first message is allowed", "retry", now, true, 0},
+		{"message within interval is suppressed", "retry", now.Add(time.Second), false, 0},
+		{"other key is not affected", "connect", now.Add(time.Second), true, 0},
+		{"message within interval is suppressed again", "retry", now.Add(2 * time.Second), false, 0},
+		{"message after interval reports suppressed count", "retry", now.Add(5 * time.Second), true, 2},
+		{"suppressed count is reset", "retry", now.Add(10 * time.Second), true, 0},
+	}
+
+	for i, tc := range tests {
+		suppressed, ok := r.allow(tc.key, 5*time.Second, tc.at)
+
+		assert.Equal(t, tc.expOK, ok, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.expSuppressed, suppressed, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestRateLimiter_Prune(t *testing.T) {
+	var r rateLimiter
+
+	now := time.Now()
+
+	r.allow("expired", time.Minute, now)
+	r.allow("suppressed", time.Minute, now)
+	r.allow("suppressed", time.Minute, now.Add(time.Second))
+	r.allow("active", time.Hour, now.Add(time.Second))
+
+	// the sweep after pruneInterval removes only the expired bucket without suppressed messages
+	r.allow("new", time.Minute, now.Add(2*time.Minute))
+
+	assert.Len(t, r.buckets, 3)
+	assert.NotContains(t, r.buckets, "expired")
+
+	suppressed, ok := r.allow("suppressed", time.Minute, now.Add(3*time.Minute))
+
+	assert.True(t, ok)
+	assert.Equal(t, 1, suppressed)
+}
+
+func TestLogger_InfoEvery(t *testing.T) {
+	log := testutil.StdoutOutputForFunc(func() {
+		logger := NewLogger(INFO)
+
+		for i := 0; i < 3; i++ {
+			logger.InfoEvery(time.Hour, "retrying connection to %s", "db")
+			logger.InfoEveryKey("attempt", time.Hour, "attempt %d", i)
+		}
+	})
+
+	assert.Equal(t, 1, strings.Count(log, "retrying connection to db"))
+	assert.Contains(t, log, "attempt 0")
+	assert.NotContains(t, log, "attempt 1")
+	assert.NotContains(t, log, "attempt 2")
+}
+
+func TestLogger_InfoEveryKeyDoesNotShareFormatBucket(t *testing.T) {
+	log := testutil.StdoutOutputForFunc(func() {
+		logger := NewLogger(INFO)
+
+		logger.InfoEveryKey("retrying %s", time.Hour, "explicit key")
+		logger.InfoEvery(time.Hour, "retrying %s", "db")
+	})
+
+	assert.Contains(t, log, "explicit key")
+	assert.Contains(t, log, "retrying db")
+}
+
+func TestLogger_InfoEveryBelowLevel(t *testing.T) {
+	log := testutil.StdoutOutputForFunc(func() {
+		logger := NewLogger(ERROR)
+
+		logger.InfoEvery(time.Hour, "retrying connection")
+	})
+
+	assert.Equal(t, "", log)
+}
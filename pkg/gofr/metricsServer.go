@@ -1,8 +1,11 @@
 package gofr
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
 	"gofr.dev/pkg/gofr/container"
@@ -11,24 +14,47 @@ import (
 
 type metricServer struct {
 	port int
+
+	mu  sync.Mutex
+	srv *http.Server
 }
 
 func newMetricServer(port int) *metricServer {
 	return &metricServer{port: port}
 }
 
-func (m *metricServer) Run(c *container.Container) {
-	var srv *http.Server
-
-	if m != nil {
-		c.Logf("Starting metrics server on port: %d", m.port)
+// server returns the underlying http.Server, creating it on first use so that Shutdown can be called before Run.
+func (m *metricServer) server(c *container.Container) *http.Server {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 
-		srv = &http.Server{
+	if m.srv == nil {
+		m.srv = &http.Server{
 			Addr:              fmt.Sprintf(":%d", m.port),
 			Handler:           metrics.GetHandler(c.Metrics()),
 			ReadHeaderTimeout: 5 * time.Second,
 		}
+	}
+
+	return m.srv
+}
 
-		c.Error(srv.ListenAndServe())
+func (m *metricServer) Run(c *container.Container) {
+	if m != nil {
+		c.Logf("Starting metrics server on port: %d", m.port)
+
+		err := m.server(c).ListenAndServe()
+		if !errors.Is(err, http.ErrServerClosed) {
+			c.Error(err)
+		}
 	}
 }
+
+// Shutdown stops the metrics server.
+func (m *metricServer) Shutdown(ctx context.Context, c *container.Container) error {
+	if m == nil {
+		return nil
+	}
+
+	return m.server(c).Shutdown(ctx)
+}
@@ -28,7 +28,7 @@ func (a *APIKeyAuthProvider) Get(ctx context.Context, path string, queryParams m
 
 func (a *APIKeyAuthProvider) GetWithHeaders(ctx context.Context, path string, queryParams map[string]interface{},
 	headers map[string]string) (*http.Response, error) {
-	setXApiKey(headers, a.apiKey)
+	a.addAPIKey(path, headers)
 
 	return a.HTTP.GetWithHeaders(ctx, path, queryParams, headers)
 }
@@ -40,7 +40,7 @@ func (a *APIKeyAuthProvider) Post(ctx context.Context, path string, queryParams
 
 func (a *APIKeyAuthProvider) PostWithHeaders(ctx context.Context, path string, queryParams map[string]interface{}, body []byte,
 	headers map[string]string) (*http.Response, error) {
-	setXApiKey(headers, a.apiKey)
+	a.addAPIKey(path, headers)
 
 	return a.HTTP.PostWithHeaders(ctx, path, queryParams, body, headers)
 }
@@ -52,7 +52,7 @@ func (a *APIKeyAuthProvider) Put(ctx context.Context, api string, queryParams ma
 
 func (a *APIKeyAuthProvider) PutWithHeaders(ctx context.Context, path string, queryParams map[string]interface{}, body []byte,
 	headers map[string]string) (*http.Response, error) {
-	setXApiKey(headers, a.apiKey)
+	a.addAPIKey(path, headers)
 
 	return a.HTTP.PutWithHeaders(ctx, path, queryParams, body, headers)
 }
@@ -64,7 +64,7 @@ func (a *APIKeyAuthProvider) Patch(ctx context.Context, path string, queryParams
 
 func (a *APIKeyAuthProvider) PatchWithHeaders(ctx context.Context, path string, queryParams map[string]interface{}, body []byte,
 	headers map[string]string) (*http.Response, error) {
-	setXApiKey(headers, a.apiKey)
+	a.addAPIKey(path, headers)
 
 	return a.HTTP.PatchWithHeaders(ctx, path, queryParams, body, headers)
 }
@@ -75,11 +75,20 @@ func (a *APIKeyAuthProvider) Delete(ctx context.Context, path string, body []byt
 
 func (a *APIKeyAuthProvider) DeleteWithHeaders(ctx context.Context, path string, body []byte, headers map[string]string) (
 	*http.Response, error) {
-	setXApiKey(headers, a.apiKey)
+	a.addAPIKey(path, headers)
 
 	return a.HTTP.DeleteWithHeaders(ctx, path, body, headers)
 }
 
+// addAPIKey sets the API key unless the request is sent to another host than the service address.
+func (a *APIKeyAuthProvider) addAPIKey(path string, headers map[string]string) {
+	if isOtherHost(a, path) {
+		return
+	}
+
+	setXApiKey(headers, a.apiKey)
+}
+
 func setXApiKey(headers map[string]string, apiKey string) {
 	if headers == nil {
 		headers = make(map[string]string)
@@ -5,9 +5,11 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	"gofr.dev/pkg/gofr/testutil"
@@ -32,8 +34,9 @@ func Test_APIKeyAuthProvider_Get(t *testing.T) {
 	}))
 	defer server.Close()
 
-	httpService := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.INFOLOG), nil,
+	httpService, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.INFOLOG), nil,
 		&APIKeyConfig{"valid-key"})
+	require.NoError(t, err)
 
 	resp, err := httpService.Get(context.Background(), path, queryParams)
 	assert.Nil(t, err)
@@ -63,8 +66,9 @@ func Test_APIKeyAuthProvider_Post(t *testing.T) {
 	}))
 	defer server.Close()
 
-	httpService := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.INFOLOG), nil,
+	httpService, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.INFOLOG), nil,
 		&APIKeyConfig{"valid-key"})
+	require.NoError(t, err)
 
 	resp, err := httpService.Post(context.Background(), path, queryParams, body)
 	assert.Nil(t, err)
@@ -90,8 +94,9 @@ func TestApiKeyProvider_Put(t *testing.T) {
 	}))
 	defer server.Close()
 
-	httpService := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.INFOLOG), nil,
+	httpService, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.INFOLOG), nil,
 		&APIKeyConfig{"valid-key"})
+	require.NoError(t, err)
 
 	resp, err := httpService.Put(context.Background(), path, queryParams, body)
 	assert.Nil(t, err)
@@ -117,8 +122,9 @@ func TestApiKeyAuthProvider_Patch(t *testing.T) {
 	}))
 	defer server.Close()
 
-	httpService := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.INFOLOG), nil,
+	httpService, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.INFOLOG), nil,
 		&APIKeyConfig{"valid-key"})
+	require.NoError(t, err)
 
 	resp, err := httpService.Patch(context.Background(), path, queryParams, body)
 	assert.Nil(t, err)
@@ -143,8 +149,9 @@ func TestApiKeyAuthProvider_Delete(t *testing.T) {
 	}))
 	defer server.Close()
 
-	httpService := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.INFOLOG), nil,
+	httpService, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.INFOLOG), nil,
 		&APIKeyConfig{"valid-key"})
+	require.NoError(t, err)
 
 	resp, err := httpService.Delete(context.Background(), path, body)
 	assert.Nil(t, err)
@@ -154,3 +161,54 @@ func TestApiKeyAuthProvider_Delete(t *testing.T) {
 	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
 	assert.Nil(t, err)
 }
+
+func TestApiKeyAuthProvider_OtherHost(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "valid-key", r.Header.Get("X-API-KEY"))
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	otherServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("X-API-KEY"))
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer otherServer.Close()
+
+	httpService, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.INFOLOG), nil,
+		&APIKeyConfig{"valid-key"})
+	require.NoError(t, err)
+
+	// the API key is sent to the service host only, even when the request uses an absolute URL
+	for _, path := range []string{"path", server.URL + "/path", otherServer.URL + "/path"} {
+		resp, err := httpService.GetWithHeaders(context.Background(), path, nil, map[string]string{})
+
+		require.NoError(t, err, path)
+		assert.Equal(t, http.StatusOK, resp.StatusCode, path)
+
+		_ = resp.Body.Close()
+	}
+}
+
+func TestApiKeyAuthProvider_SchemeDowngrade(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("X-API-KEY"))
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// the service address uses https, so a plain http URL to the same host must not carry the credentials
+	httpService, err := NewHTTPService(strings.Replace(server.URL, "http://", "https://", 1),
+		testutil.NewMockLogger(testutil.INFOLOG), nil, &APIKeyConfig{"valid-key"})
+	require.NoError(t, err)
+
+	resp, err := httpService.GetWithHeaders(context.Background(), server.URL+"/path", nil, map[string]string{})
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	_ = resp.Body.Close()
+}
@@ -0,0 +1,21 @@
+package service
+
+import "gofr.dev/pkg/gofr/internal/background"
+
+// goroutines tracks the goroutines spawned by the package, i.e. one health check loop per circuit breaker until it
+// is closed.
+var goroutines background.Counter
+
+// BackgroundGoroutines returns the number of goroutines spawned by the package that are still running. It allows
+// tests to verify that background tasks exit when expected and do not leak.
+func BackgroundGoroutines() int64 {
+	return goroutines.Active()
+}
+
+// Close stops the background goroutines of the circuit breakers in the chain of options wrapping h, see
+// CircuitBreaker.Close. The service still serves requests.
+func Close(h HTTP) {
+	for _, cb := range h.circuitBreakers() {
+		cb.Close()
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	"gofr.dev/pkg/gofr/testutil"
@@ -37,8 +38,9 @@ func TestBasicAuthProvider_Get(t *testing.T) {
 	defer server.Close()
 
 	// Create a new HTTP service instance with basic auth
-	httpService := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.INFOLOG), nil,
+	httpService, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.INFOLOG), nil,
 		&BasicAuthConfig{UserName: "user", Password: "cGFzc3dvcmQ="})
+	require.NoError(t, err)
 
 	// Make the GET request
 	resp, err := httpService.Get(context.Background(), path, queryParams)
@@ -73,8 +75,9 @@ func TestBasicAuthProvider_Post(t *testing.T) {
 	defer server.Close()
 
 	// Create a new HTTP service instance with basic auth
-	httpService := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.INFOLOG), nil,
+	httpService, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.INFOLOG), nil,
 		&BasicAuthConfig{UserName: "user", Password: "cGFzc3dvcmQ="})
+	require.NoError(t, err)
 
 	// Make the POST request
 	resp, err := httpService.Post(context.Background(), path, queryParams, body)
@@ -105,8 +108,9 @@ func TestBasicAuthProvider_Put(t *testing.T) {
 	defer server.Close()
 
 	// Create a new HTTP service instance with basic auth
-	httpService := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.INFOLOG), nil,
+	httpService, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.INFOLOG), nil,
 		&BasicAuthConfig{UserName: "user", Password: "cGFzc3dvcmQ="})
+	require.NoError(t, err)
 
 	// Make the PUT request
 	resp, err := httpService.Put(context.Background(), path, queryParams, body)
@@ -137,8 +141,9 @@ func TestBasicAuthProvider_Patch(t *testing.T) {
 	defer server.Close()
 
 	// Create a new HTTP service instance with basic auth
-	httpService := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.INFOLOG), nil,
+	httpService, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.INFOLOG), nil,
 		&BasicAuthConfig{UserName: "user", Password: "cGFzc3dvcmQ="})
+	require.NoError(t, err)
 
 	// Make the PATCH request
 	resp, err := httpService.Patch(context.Background(), path, queryParams, body)
@@ -168,8 +173,9 @@ func TestBasicAuthProvider_Delete(t *testing.T) {
 	defer server.Close()
 
 	// Create a new HTTP service instance with basic auth
-	httpService := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.INFOLOG), nil,
+	httpService, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.INFOLOG), nil,
 		&BasicAuthConfig{UserName: "user", Password: "cGFzc3dvcmQ="})
+	require.NoError(t, err)
 
 	// Make the DELETE request
 	resp, err := httpService.Delete(context.Background(), path, body)
@@ -210,3 +216,55 @@ func Test_addAuthorizationHeader_Error(t *testing.T) {
 	expectedErrMsg := "illegal base64 data at input byte 7"
 	assert.Equal(t, expectedErrMsg, err.Error(), "Test_addAuthorizationHeader_Error Failed!")
 }
+
+func TestBasicAuthProvider_OtherHost(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NotEmpty(t, r.Header.Get("Authorization"))
+		checkAuthHeaders(r, t)
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	otherServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer otherServer.Close()
+
+	httpService, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.INFOLOG), nil,
+		&BasicAuthConfig{UserName: "user", Password: "cGFzc3dvcmQ="})
+	require.NoError(t, err)
+
+	// the credentials are sent to the service host only, even when the request uses an absolute URL
+	for _, path := range []string{"path", server.URL + "/path", otherServer.URL + "/path"} {
+		resp, err := httpService.GetWithHeaders(context.Background(), path, nil, map[string]string{})
+
+		require.NoError(t, err, path)
+		assert.Equal(t, http.StatusOK, resp.StatusCode, path)
+
+		_ = resp.Body.Close()
+	}
+}
+
+func TestBasicAuthProvider_SchemeDowngrade(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// the service address uses https, so a plain http URL to the same host must not carry the credentials
+	httpService, err := NewHTTPService(strings.Replace(server.URL, "http://", "https://", 1),
+		testutil.NewMockLogger(testutil.INFOLOG), nil, &BasicAuthConfig{UserName: "user", Password: "cGFzc3dvcmQ="})
+	require.NoError(t, err)
+
+	resp, err := httpService.GetWithHeaders(context.Background(), server.URL+"/path", nil, map[string]string{})
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	_ = resp.Body.Close()
+}
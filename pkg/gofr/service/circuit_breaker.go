@@ -3,7 +3,9 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 )
@@ -12,6 +14,14 @@ import (
 const (
 	ClosedState = iota
 	OpenState
+	// RecoveringState admits spaced-out trial requests after the circuit was open, see CircuitBreakerConfig.RecoverySpacing.
+	RecoveringState
+)
+
+const (
+	defaultRecoveryProbes = 3
+	// maxRecoveryBackoff caps the spacing between trial requests during the recovery phase.
+	maxRecoveryBackoff = time.Hour
 )
 
 var (
@@ -24,69 +34,266 @@ var (
 type CircuitBreakerConfig struct {
 	Threshold int           // Threshold represents the max no of retry before switching the circuit breaker state.
 	Interval  time.Duration // Interval represents the time interval duration between hitting the HealthURL
+	// DrainOnShutdown makes the application shut down gracefully on SIGINT or SIGTERM, opening the circuit first so
+	// that requests still in flight fail fast instead of reaching a dependency that may be terminating as well.
+	// It is disabled by default.
+	DrainOnShutdown bool
+	// DegradedHeader is the name of a response header through which the service signals that it is degraded.
+	// Responses carrying it are counted as failures even when the request succeeds, and returned to the caller
+	// unless the failure opens the circuit, in which case the response is closed and ErrCircuitOpen is returned.
+	// It is disabled when empty.
+	DegradedHeader string
+	// DegradedValue is the value of DegradedHeader, compared case-insensitively, that signals degradation. A header
+	// repeated in the response signals degradation if any of its values matches. When empty, the presence of the
+	// header alone signals degradation.
+	DegradedValue string
+	// RecoverySpacing enables a recovery phase after an open circuit recovers. During the phase only one trial request
+	// is admitted at a time, the first one immediately and the following ones after RecoverySpacing, doubling after
+	// every successful trial (1x, 2x, 4x...) up to one hour, while other requests fail with ErrCircuitOpen. A failed
+	// trial opens the circuit again and returns its own error or response. It is disabled when zero.
+	RecoverySpacing time.Duration
+	// RecoveryProbes is the number of successful trial requests that close the circuit, defaults to 3.
+	RecoveryProbes int
+}
+
+// CircuitBreakerStats is a snapshot of the state of a CircuitBreaker.
+type CircuitBreakerStats struct {
+	State        int // ClosedState, OpenState or RecoveringState
+	FailureCount int
+	LastChecked  time.Time
+	// LastSuccess is the time of the last successful request or health check, zero if none succeeded yet.
+	LastSuccess time.Time
+	// RecoveryProbe is the number of successful trial requests in the current recovery phase.
+	RecoveryProbe int
+	// NextProbe is the time from which the next trial request is admitted, zero outside the recovery phase.
+	NextProbe time.Time
+	// Degraded reports whether the last response carried the degradation signal configured by DegradedHeader.
+	Degraded bool
+	// DegradedResponses is the total number of responses that carried the degradation signal.
+	DegradedResponses int
+}
+
+// String formats the stats as space separated key=value pairs, with times in RFC 3339.
+func (s CircuitBreakerStats) String() string {
+	formatTime := func(t time.Time) string {
+		if t.IsZero() {
+			return "never"
+		}
+
+		return t.Format(time.RFC3339)
+	}
+
+	states := map[int]string{ClosedState: "closed", OpenState: "open", RecoveringState: "recovering"}
+
+	str := fmt.Sprintf("state=%s failures=%d lastChecked=%s lastSuccess=%s degraded=%t degradedResponses=%d",
+		states[s.State], s.FailureCount, formatTime(s.LastChecked), formatTime(s.LastSuccess), s.Degraded,
+		s.DegradedResponses)
+
+	if s.State == RecoveringState {
+		str += fmt.Sprintf(" recoveryProbe=%d nextProbe=%s", s.RecoveryProbe, formatTime(s.NextProbe))
+	}
+
+	return str
 }
 
 // CircuitBreaker represents a circuit breaker implementation.
 type CircuitBreaker struct {
-	mu           sync.RWMutex
-	state        int // ClosedState or OpenState
-	failureCount int
-	threshold    int
-	interval     time.Duration
-	lastChecked  time.Time
+	mu              sync.RWMutex
+	state           int // ClosedState, OpenState or RecoveringState
+	failureCount    int
+	threshold       int
+	interval        time.Duration
+	lastChecked     time.Time
+	lastSuccess     time.Time
+	draining        bool // draining keeps the circuit open until the process exits
+	drainOnShutdown bool
+	now             func() time.Time
+
+	recoverySpacing time.Duration
+	recoveryProbes  int
+	recoveryProbe   int
+	nextProbe       time.Time
+	probing         bool // probing is set while a trial request of the recovery phase is in flight
+
+	degradedHeader    string
+	degradedValue     string
+	degraded          bool
+	degradedResponses int
+
+	stop     chan struct{} // stop ends the periodic health checks
+	stopOnce sync.Once
+
+	// hosts tracks requests overriding the service address with an absolute URL to another host, by origin.
+	hosts map[string]*hostCircuit
 
 	HTTP
 }
 
-// NewCircuitBreaker creates a new CircuitBreaker instance based on the provided config.
+// hostCircuit is the circuit state of another host than the service address. The host has no health check, so once
+// open, a single trial request is admitted every interval and the entry is removed after a successful request.
+type hostCircuit struct {
+	failureCount int
+	open         bool
+	lastChecked  time.Time
+}
+
+// NewCircuitBreaker creates a new CircuitBreaker instance based on the provided config. A non-positive Interval,
+// which cannot be used for the health check ticker, is replaced by the default of 10s.
 func NewCircuitBreaker(config CircuitBreakerConfig, h HTTP) *CircuitBreaker {
+	if config.Interval <= 0 {
+		config.Interval = defaultInterval
+	}
+
 	cb := &CircuitBreaker{
 		state:     ClosedState,
 		threshold: config.Threshold,
 		interval:  config.Interval,
 		HTTP:      h,
+
+		now:             time.Now,
+		drainOnShutdown: config.DrainOnShutdown,
+
+		degradedHeader: config.DegradedHeader,
+		degradedValue:  config.DegradedValue,
+
+		recoverySpacing: config.RecoverySpacing,
+		recoveryProbes:  config.RecoveryProbes,
+
+		hosts: make(map[string]*hostCircuit),
+		stop:  make(chan struct{}),
+	}
+
+	if cb.recoveryProbes <= 0 {
+		cb.recoveryProbes = defaultRecoveryProbes
 	}
 
 	// Perform asynchronous health checks
-	go cb.startHealthChecks()
+	goroutines.Go(cb.startHealthChecks)
 
 	return cb
 }
 
-// executeWithCircuitBreaker executes the given function with circuit breaker protection.
+// executeWithCircuitBreaker executes the given function with circuit breaker protection. The function and health
+// checks run without holding the lock, so that a slow service does not block other requests or Stats.
 func (cb *CircuitBreaker) executeWithCircuitBreaker(ctx context.Context, f func(ctx context.Context) (*http.Response,
 	error)) (*http.Response, error) {
 	cb.mu.Lock()
-	defer cb.mu.Unlock()
 
 	if cb.state == OpenState {
-		if time.Since(cb.lastChecked) > cb.interval {
-			// Check health before potentially closing the circuit
-			if cb.healthCheck(ctx) {
-				cb.resetCircuit()
-				return nil, nil
-			}
+		due := cb.recoveryDue()
+		cb.mu.Unlock()
+
+		// Check health before potentially closing the circuit
+		if due && cb.healthCheck(ctx) {
+			cb.recoverCircuit()
+			return nil, nil
 		}
 
 		return nil, ErrCircuitOpen
 	}
 
+	recovering := cb.state == RecoveringState
+	if recovering {
+		// only one trial request is admitted at a time
+		if cb.probing || cb.now().Before(cb.nextProbe) {
+			cb.mu.Unlock()
+			return nil, ErrCircuitOpen
+		}
+
+		cb.probing = true
+	}
+
+	cb.mu.Unlock()
+
 	result, err := f(ctx)
 
-	if err != nil {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	// the circuit may have been opened by another request or drained while the request was in flight
+	wasOpen := cb.state == OpenState
+
+	// a degraded response is counted as a soft failure, and returned to the caller unless it opens the circuit
+	failed := err != nil || cb.isDegraded(result)
+	if failed {
 		cb.handleFailure()
 	} else {
 		cb.resetFailureCount()
 	}
 
-	if cb.failureCount > cb.threshold {
-		cb.openCircuit()
+	if recovering {
+		cb.probing = false
+
+		if cb.state == RecoveringState {
+			cb.handleRecoveryProbe(failed)
+		}
+
+		// the trial request's own error or response is returned, even when it opened the circuit again
+		return result, err
+	}
+
+	if !wasOpen && cb.state == OpenState {
+		if result != nil {
+			result.Body.Close()
+		}
+
 		return nil, ErrCircuitOpen
 	}
 
 	return result, err
 }
 
+// isDegraded records whether the response carries the configured degradation signal and returns it.
+func (cb *CircuitBreaker) isDegraded(resp *http.Response) bool {
+	if cb.degradedHeader == "" || resp == nil {
+		return false
+	}
+
+	cb.degraded = cb.hasDegradedSignal(resp)
+	if cb.degraded {
+		cb.degradedResponses++
+	}
+
+	return cb.degraded
+}
+
+// hasDegradedSignal returns true if the response carries the configured degradation signal.
+func (cb *CircuitBreaker) hasDegradedSignal(resp *http.Response) bool {
+	if cb.degradedHeader == "" || resp == nil {
+		return false
+	}
+
+	values, ok := resp.Header[http.CanonicalHeaderKey(cb.degradedHeader)]
+	if !ok || cb.degradedValue == "" {
+		return ok
+	}
+
+	for _, value := range values {
+		if strings.EqualFold(value, cb.degradedValue) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Stats returns a snapshot of the current state of the circuit breaker.
+func (cb *CircuitBreaker) Stats() CircuitBreakerStats {
+	cb.mu.RLock()
+	defer cb.mu.RUnlock()
+
+	return CircuitBreakerStats{
+		State:             cb.state,
+		FailureCount:      cb.failureCount,
+		LastChecked:       cb.lastChecked,
+		LastSuccess:       cb.lastSuccess,
+		RecoveryProbe:     cb.recoveryProbe,
+		NextProbe:         cb.nextProbe,
+		Degraded:          cb.degraded,
+		DegradedResponses: cb.degradedResponses,
+	}
+}
+
 // isOpen returns true if the circuit breaker is in the open state.
 func (cb *CircuitBreaker) isOpen() bool {
 	cb.mu.Lock()
@@ -102,54 +309,153 @@ func (cb *CircuitBreaker) healthCheck(ctx context.Context) bool {
 	return resp.Status == serviceUp
 }
 
-// startHealthChecks initiates periodic health checks.
+// startHealthChecks initiates periodic health checks until the circuit breaker is closed. A health check runs in
+// the same goroutine, so checks of a service slower than the interval do not pile up.
 func (cb *CircuitBreaker) startHealthChecks() {
 	ticker := time.NewTicker(cb.interval)
-
-	for range ticker.C {
-		if cb.isOpen() {
-			go func() {
-				if cb.healthCheck(context.TODO()) {
-					cb.resetCircuit()
-				}
-			}()
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-cb.stop:
+			return
+		case <-ticker.C:
+			cb.mu.RLock()
+			due := cb.state == OpenState && !cb.draining
+			cb.mu.RUnlock()
+
+			if due && cb.healthCheck(context.TODO()) {
+				cb.recoverCircuit()
+			}
 		}
 	}
 }
 
+// Close stops the periodic health checks of the circuit breaker and the goroutine running them. The circuit breaker
+// still serves requests, and an open circuit recovers through the health check made by a request after the interval.
+func (cb *CircuitBreaker) Close() {
+	cb.stopOnce.Do(func() {
+		close(cb.stop)
+	})
+}
+
 // openCircuit transitions the circuit breaker to the open state.
 func (cb *CircuitBreaker) openCircuit() {
 	cb.state = OpenState
-	cb.lastChecked = time.Now()
+	cb.lastChecked = cb.now()
+	cb.recoveryProbe = 0
+	cb.nextProbe = time.Time{}
+}
+
+// recoveryDue returns true if the interval since the circuit opened has elapsed and the circuit breaker is not
+// draining, as a draining circuit breaker does not reach the service with health checks. The caller must hold cb.mu.
+func (cb *CircuitBreaker) recoveryDue() bool {
+	return !cb.draining && cb.now().Sub(cb.lastChecked) > cb.interval
+}
+
+// recoverCircuit resets the circuit after a successful health check made without holding the lock, unless the
+// circuit was reset in the meantime. It returns false if the circuit is still open.
+func (cb *CircuitBreaker) recoverCircuit() bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.state == OpenState {
+		cb.resetCircuit()
+	}
+
+	return cb.state != OpenState
 }
 
-// resetCircuit transitions the circuit breaker to the closed state.
+// resetCircuit transitions the circuit breaker to the closed state unless it is draining. When RecoverySpacing
+// is configured, an open circuit transitions to the recovering state instead. The caller must hold cb.mu.
 func (cb *CircuitBreaker) resetCircuit() {
-	cb.state = ClosedState
+	cb.lastSuccess = cb.now()
+
+	if cb.draining {
+		return
+	}
+
+	switch {
+	case cb.state == OpenState && cb.recoverySpacing > 0:
+		cb.state = RecoveringState
+		cb.recoveryProbe = 0
+		cb.nextProbe = cb.now()
+	case cb.state != RecoveringState:
+		cb.state = ClosedState
+	}
+
 	cb.failureCount = 0
 }
 
-// handleFailure increments the failure count and opens the circuit if the threshold is reached.
+// handleRecoveryProbe opens the circuit again if the trial request failed, otherwise it schedules the next trial
+// request, or closes the circuit once enough trial requests have succeeded.
+func (cb *CircuitBreaker) handleRecoveryProbe(failed bool) {
+	if failed {
+		cb.openCircuit()
+		return
+	}
+
+	cb.recoveryProbe++
+
+	if cb.recoveryProbe >= cb.recoveryProbes {
+		cb.state = ClosedState
+		cb.recoveryProbe = 0
+		cb.nextProbe = time.Time{}
+
+		return
+	}
+
+	cb.nextProbe = cb.now().Add(recoveryBackoff(cb.recoverySpacing, cb.recoveryProbe))
+}
+
+// recoveryBackoff returns the spacing after the given number of successful trial requests, doubling with every
+// trial and capped at maxRecoveryBackoff, or at spacing if it is larger.
+func recoveryBackoff(spacing time.Duration, probe int) time.Duration {
+	limit := maxRecoveryBackoff
+	if spacing > limit {
+		limit = spacing
+	}
+
+	backoff := spacing
+
+	for i := 1; i < probe; i++ {
+		if backoff > limit/2 {
+			return limit
+		}
+
+		backoff *= 2
+	}
+
+	return backoff
+}
+
+// handleFailure increments the failure count and opens the closed circuit if the threshold is reached.
 func (cb *CircuitBreaker) handleFailure() {
 	cb.failureCount++
-	if cb.failureCount > cb.threshold {
+	if cb.state == ClosedState && cb.failureCount > cb.threshold {
 		cb.openCircuit()
 	}
 }
 
-// resetFailureCount resets the failure count to zero.
+// resetFailureCount resets the failure count to zero and records the time of the successful request.
 func (cb *CircuitBreaker) resetFailureCount() {
 	cb.failureCount = 0
+	cb.lastSuccess = cb.now()
 }
 
 func (cb *CircuitBreakerConfig) addOption(h HTTP) HTTP {
 	return NewCircuitBreaker(*cb, h)
 }
 
+// tryCircuitRecovery checks the health of the service once the interval since the circuit opened has elapsed, and
+// resets the circuit if it is healthy. The health check is made without holding the lock.
 func (cb *CircuitBreaker) tryCircuitRecovery() bool {
-	if time.Since(cb.lastChecked) > cb.interval && cb.healthCheck(context.TODO()) {
-		cb.resetCircuit()
-		return true
+	cb.mu.RLock()
+	due := cb.recoveryDue()
+	cb.mu.RUnlock()
+
+	if due && cb.healthCheck(context.TODO()) {
+		return cb.recoverCircuit()
 	}
 
 	return false
@@ -170,45 +476,106 @@ func (cb *CircuitBreaker) handleCircuitBreakerResult(result interface{}, err err
 
 func (cb *CircuitBreaker) doRequest(ctx context.Context, method, path string, queryParams map[string]interface{},
 	body []byte, headers map[string]string) (*http.Response, error) {
+	// requests overriding the service address with an absolute URL to another host are tracked by origin
+	if isOtherHost(cb, path) {
+		u, _ := absoluteURL(path)
+
+		return cb.executeForHost(strings.ToLower(u.Scheme+"://"+u.Host), func() (*http.Response, error) {
+			return cb.sendRequest(ctx, method, path, queryParams, body, headers)
+		})
+	}
+
 	if cb.isOpen() {
 		if !cb.tryCircuitRecovery() {
 			return nil, ErrCircuitOpen
 		}
 	}
 
-	var result interface{}
+	result, err := cb.executeWithCircuitBreaker(ctx, func(ctx context.Context) (*http.Response, error) {
+		return cb.sendRequest(ctx, method, path, queryParams, body, headers)
+	})
 
-	var err error
+	resp, err := cb.handleCircuitBreakerResult(result, err)
+	if err != nil {
+		return nil, err
+	}
 
+	return resp, err
+}
+
+// sendRequest sends the request through the wrapped HTTP service.
+func (cb *CircuitBreaker) sendRequest(ctx context.Context, method, path string, queryParams map[string]interface{},
+	body []byte, headers map[string]string) (*http.Response, error) {
 	switch method {
 	case http.MethodGet:
-		result, err = cb.executeWithCircuitBreaker(ctx, func(ctx context.Context) (*http.Response, error) {
-			return cb.HTTP.GetWithHeaders(ctx, path, queryParams, headers)
-		})
+		return cb.HTTP.GetWithHeaders(ctx, path, queryParams, headers)
 	case http.MethodPost:
-		result, err = cb.executeWithCircuitBreaker(ctx, func(ctx context.Context) (*http.Response, error) {
-			return cb.HTTP.PostWithHeaders(ctx, path, queryParams, body, headers)
-		})
+		return cb.HTTP.PostWithHeaders(ctx, path, queryParams, body, headers)
 	case http.MethodPatch:
-		result, err = cb.executeWithCircuitBreaker(ctx, func(ctx context.Context) (*http.Response, error) {
-			return cb.HTTP.PatchWithHeaders(ctx, path, queryParams, body, headers)
-		})
+		return cb.HTTP.PatchWithHeaders(ctx, path, queryParams, body, headers)
 	case http.MethodPut:
-		result, err = cb.executeWithCircuitBreaker(ctx, func(ctx context.Context) (*http.Response, error) {
-			return cb.HTTP.PutWithHeaders(ctx, path, queryParams, body, headers)
-		})
+		return cb.HTTP.PutWithHeaders(ctx, path, queryParams, body, headers)
 	case http.MethodDelete:
-		result, err = cb.executeWithCircuitBreaker(ctx, func(ctx context.Context) (*http.Response, error) {
-			return cb.HTTP.DeleteWithHeaders(ctx, path, body, headers)
-		})
+		return cb.HTTP.DeleteWithHeaders(ctx, path, body, headers)
 	}
 
-	resp, err := cb.handleCircuitBreakerResult(result, err)
-	if err != nil {
-		return nil, err
+	return nil, nil
+}
+
+// executeForHost executes the given function with the circuit state of another host than the service address. The
+// request is sent without holding the lock, so that a slow host does not block requests to the service address.
+func (cb *CircuitBreaker) executeForHost(host string, f func() (*http.Response, error)) (*http.Response, error) {
+	cb.mu.Lock()
+
+	hc, ok := cb.hosts[host]
+	if !ok {
+		hc = &hostCircuit{}
+		cb.hosts[host] = hc
 	}
 
-	return resp, err
+	trial := hc.open
+	if cb.draining || (trial && cb.now().Sub(hc.lastChecked) <= cb.interval) {
+		cb.mu.Unlock()
+
+		return nil, ErrCircuitOpen
+	}
+
+	if trial {
+		// postpones other trial requests by an interval
+		hc.lastChecked = cb.now()
+	}
+
+	cb.mu.Unlock()
+
+	result, err := f()
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if err == nil && !cb.hasDegradedSignal(result) {
+		delete(cb.hosts, host)
+
+		return result, err
+	}
+
+	hc.failureCount++
+
+	switch {
+	case trial:
+		// the trial request's own error or response is returned, as during the recovery phase
+		hc.lastChecked = cb.now()
+	case hc.failureCount > cb.threshold:
+		hc.open = true
+		hc.lastChecked = cb.now()
+
+		if result != nil {
+			result.Body.Close()
+		}
+
+		return nil, ErrCircuitOpen
+	}
+
+	return result, err
 }
 
 func (cb *CircuitBreaker) GetWithHeaders(ctx context.Context, path string, queryParams map[string]interface{},
@@ -0,0 +1,100 @@
+package service
+
+import "time"
+
+// Defaults applied by NewCircuitBreakerWithOptions and CircuitBreakerOptions.
+const (
+	defaultThreshold = 5
+	defaultInterval  = 10 * time.Second
+)
+
+// CircuitBreakerOption configures a circuit breaker created with NewCircuitBreakerWithOptions or CircuitBreakerOptions.
+type CircuitBreakerOption func(*CircuitBreakerConfig)
+
+// NewCircuitBreakerWithOptions creates a new CircuitBreaker instance configured by the given options:
+//
+//   - WithThreshold: consecutive failures tolerated, the circuit opens on the next one, defaults to 5.
+//   - WithInterval: interval between health checks while the circuit is open, defaults to 10s.
+//   - WithDrainOnShutdown: opens the circuit on a shutdown signal, disabled by default.
+//   - WithDegradedHeader: response header signalling degradation, disabled by default.
+//   - WithRecoverySpacing: spacing of trial requests while recovering, disabled by default.
+//   - WithRecoveryProbes: successful trial requests that close the circuit, defaults to 3.
+//
+// Invalid values, i.e. a negative threshold or a non-positive interval or number of recovery probes, keep the defaults.
+func NewCircuitBreakerWithOptions(h HTTP, opts ...CircuitBreakerOption) *CircuitBreaker {
+	return NewCircuitBreaker(newCircuitBreakerConfig(opts...), h)
+}
+
+// CircuitBreakerOptions returns an option for NewHTTPService and AddHTTPService that wraps the service in a
+// circuit breaker configured by the given options, see NewCircuitBreakerWithOptions for the defaults.
+func CircuitBreakerOptions(opts ...CircuitBreakerOption) Options {
+	config := newCircuitBreakerConfig(opts...)
+
+	return &config
+}
+
+func newCircuitBreakerConfig(opts ...CircuitBreakerOption) CircuitBreakerConfig {
+	config := CircuitBreakerConfig{
+		Threshold:      defaultThreshold,
+		Interval:       defaultInterval,
+		RecoveryProbes: defaultRecoveryProbes,
+	}
+
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return config
+}
+
+// WithThreshold sets the number of consecutive failures tolerated, the circuit opens on the next failure. A threshold
+// of 0 opens the circuit on the first failure, negative values keep the default.
+func WithThreshold(threshold int) CircuitBreakerOption {
+	return func(c *CircuitBreakerConfig) {
+		if threshold >= 0 {
+			c.Threshold = threshold
+		}
+	}
+}
+
+// WithInterval sets the interval between health checks while the circuit is open, non-positive values keep the default.
+func WithInterval(interval time.Duration) CircuitBreakerOption {
+	return func(c *CircuitBreakerConfig) {
+		if interval > 0 {
+			c.Interval = interval
+		}
+	}
+}
+
+// WithDrainOnShutdown opens the circuit when the application receives a shutdown signal.
+func WithDrainOnShutdown() CircuitBreakerOption {
+	return func(c *CircuitBreakerConfig) {
+		c.DrainOnShutdown = true
+	}
+}
+
+// WithDegradedHeader counts responses carrying the header as failures. When value is empty, the presence of the
+// header alone signals degradation.
+func WithDegradedHeader(header, value string) CircuitBreakerOption {
+	return func(c *CircuitBreakerConfig) {
+		c.DegradedHeader = header
+		c.DegradedValue = value
+	}
+}
+
+// WithRecoverySpacing enables the recovery phase, spacing trial requests by spacing, doubling after every success.
+func WithRecoverySpacing(spacing time.Duration) CircuitBreakerOption {
+	return func(c *CircuitBreakerConfig) {
+		c.RecoverySpacing = spacing
+	}
+}
+
+// WithRecoveryProbes sets the number of successful trial requests that close the circuit during the recovery phase,
+// non-positive values keep the default.
+func WithRecoveryProbes(probes int) CircuitBreakerOption {
+	return func(c *CircuitBreakerConfig) {
+		if probes > 0 {
+			c.RecoveryProbes = probes
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gofr.dev/pkg/gofr/testutil"
+)
+
+func Test_newCircuitBreakerConfig(t *testing.T) {
+	tests := []struct {
+		desc      string
+		opts      []CircuitBreakerOption
+		expConfig CircuitBreakerConfig
+	}{
+		{"defaults", nil, CircuitBreakerConfig{Threshold: 5, Interval: 10 * time.Second, RecoveryProbes: 3}},
+		{"all options", []CircuitBreakerOption{
+			WithThreshold(2),
+			WithInterval(time.Second),
+			WithDrainOnShutdown(),
+			WithDegradedHeader("X-Degraded", "true"),
+			WithRecoverySpacing(time.Millisecond),
+			WithRecoveryProbes(4),
+		}, CircuitBreakerConfig{
+			Threshold:       2,
+			Interval:        time.Second,
+			DrainOnShutdown: true,
+			DegradedHeader:  "X-Degraded",
+			DegradedValue:   "true",
+			RecoverySpacing: time.Millisecond,
+			RecoveryProbes:  4,
+		}},
+		{"invalid values keep the defaults", []CircuitBreakerOption{
+			WithThreshold(-1),
+			WithInterval(-time.Second),
+			WithRecoveryProbes(0),
+		}, CircuitBreakerConfig{Threshold: 5, Interval: 10 * time.Second, RecoveryProbes: 3}},
+		{"zero threshold", []CircuitBreakerOption{WithThreshold(0)},
+			CircuitBreakerConfig{Threshold: 0, Interval: 10 * time.Second, RecoveryProbes: 3}},
+	}
+
+	for i, tc := range tests {
+		assert.Equal(t, tc.expConfig, newCircuitBreakerConfig(tc.opts...), "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestNewCircuitBreakerWithOptions(t *testing.T) {
+	server := testServer()
+	defer server.Close()
+
+	svc, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), nil,
+		CircuitBreakerOptions(WithThreshold(1), WithInterval(time.Hour)))
+	require.NoError(t, err)
+
+	cb, ok := svc.(*CircuitBreaker)
+	require.True(t, ok)
+
+	defer cb.Close()
+
+	assert.Equal(t, 1, cb.threshold)
+	assert.Equal(t, time.Hour, cb.interval)
+
+	resp, err := svc.Get(context.Background(), "test", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	_ = resp.Body.Close()
+
+	plain, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), nil)
+	require.NoError(t, err)
+
+	cb = NewCircuitBreakerWithOptions(plain, WithRecoverySpacing(time.Second))
+	defer cb.Close()
+
+	assert.Equal(t, defaultThreshold, cb.threshold)
+	assert.Equal(t, time.Second, cb.recoverySpacing)
+	assert.Equal(t, plain, cb.HTTP)
+}
+
+func TestNewCircuitBreaker_Defaults(t *testing.T) {
+	// a zero interval would make the health check ticker panic
+	cb := NewCircuitBreaker(CircuitBreakerConfig{}, &httpService{})
+	defer cb.Close()
+
+	// a zero threshold is kept, it opens the circuit on the first failure
+	assert.Equal(t, 0, cb.threshold)
+	assert.Equal(t, defaultInterval, cb.interval)
+	assert.Equal(t, defaultRecoveryProbes, cb.recoveryProbes)
+}
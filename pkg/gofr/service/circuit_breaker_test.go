@@ -7,10 +7,14 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel"
 
 	"gofr.dev/pkg/gofr/testutil"
@@ -58,10 +62,11 @@ func TestHttpService_GetSuccessRequests(t *testing.T) {
 	mockMetric.On("RecordHistogram", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(nil)
 
-	service := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), mockMetric, &CircuitBreakerConfig{
+	service, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), mockMetric, &CircuitBreakerConfig{
 		Threshold: 1,
 		Interval:  1,
 	})
+	require.NoError(t, err)
 
 	resp, err := service.Get(context.Background(), "test", nil)
 
@@ -80,10 +85,11 @@ func TestHttpService_GetWithHeaderSuccessRequests(t *testing.T) {
 	mockMetric.On("RecordHistogram", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(nil)
 
-	service := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), mockMetric, &CircuitBreakerConfig{
+	service, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), mockMetric, &CircuitBreakerConfig{
 		Threshold: 1,
 		Interval:  1,
 	})
+	require.NoError(t, err)
 
 	resp, err := service.GetWithHeaders(context.Background(), "test", nil, nil)
 
@@ -164,10 +170,11 @@ func TestHttpService_PutSuccessRequests(t *testing.T) {
 	mockMetric.On("RecordHistogram", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(nil)
 
-	service := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), mockMetric, &CircuitBreakerConfig{
+	service, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), mockMetric, &CircuitBreakerConfig{
 		Threshold: 1,
 		Interval:  1,
 	})
+	require.NoError(t, err)
 
 	resp, err := service.Put(context.Background(), "test", nil, nil)
 
@@ -186,10 +193,11 @@ func TestHttpService_PutWithHeaderSuccessRequests(t *testing.T) {
 	mockMetric.On("RecordHistogram", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(nil)
 
-	service := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), mockMetric, &CircuitBreakerConfig{
+	service, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), mockMetric, &CircuitBreakerConfig{
 		Threshold: 1,
 		Interval:  1,
 	})
+	require.NoError(t, err)
 
 	resp, err := service.PutWithHeaders(context.Background(), "test", nil, nil, nil)
 
@@ -270,10 +278,11 @@ func TestHttpService_PatchSuccessRequests(t *testing.T) {
 	mockMetric.On("RecordHistogram", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(nil)
 
-	service := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), mockMetric, &CircuitBreakerConfig{
+	service, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), mockMetric, &CircuitBreakerConfig{
 		Threshold: 1,
 		Interval:  1,
 	})
+	require.NoError(t, err)
 
 	resp, err := service.Get(context.Background(), "test", nil)
 
@@ -292,10 +301,11 @@ func TestHttpService_PatchWithHeaderSuccessRequests(t *testing.T) {
 	mockMetric.On("RecordHistogram", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(nil)
 
-	service := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), mockMetric, &CircuitBreakerConfig{
+	service, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), mockMetric, &CircuitBreakerConfig{
 		Threshold: 1,
 		Interval:  1,
 	})
+	require.NoError(t, err)
 
 	resp, err := service.GetWithHeaders(context.Background(), "test", nil, nil)
 
@@ -376,10 +386,11 @@ func TestHttpService_PostSuccessRequests(t *testing.T) {
 	mockMetric.On("RecordHistogram", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(nil)
 
-	service := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), mockMetric, &CircuitBreakerConfig{
+	service, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), mockMetric, &CircuitBreakerConfig{
 		Threshold: 1,
 		Interval:  1,
 	})
+	require.NoError(t, err)
 
 	resp, err := service.Post(context.Background(), "test", nil, nil)
 
@@ -398,10 +409,11 @@ func TestHttpService_PostWithHeaderSuccessRequests(t *testing.T) {
 	mockMetric.On("RecordHistogram", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(nil)
 
-	service := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), mockMetric, &CircuitBreakerConfig{
+	service, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), mockMetric, &CircuitBreakerConfig{
 		Threshold: 1,
 		Interval:  1,
 	})
+	require.NoError(t, err)
 
 	resp, err := service.PostWithHeaders(context.Background(), "test", nil, nil, nil)
 
@@ -482,10 +494,11 @@ func TestHttpService_DeleteSuccessRequests(t *testing.T) {
 	mockMetric.On("RecordHistogram", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(nil)
 
-	service := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), mockMetric, &CircuitBreakerConfig{
+	service, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), mockMetric, &CircuitBreakerConfig{
 		Threshold: 1,
 		Interval:  1,
 	})
+	require.NoError(t, err)
 
 	resp, err := service.Delete(context.Background(), "test", nil)
 
@@ -504,10 +517,11 @@ func TestHttpService_DeleteWithHeaderSuccessRequests(t *testing.T) {
 	mockMetric.On("RecordHistogram", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(nil)
 
-	service := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), mockMetric, &CircuitBreakerConfig{
+	service, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), mockMetric, &CircuitBreakerConfig{
 		Threshold: 1,
 		Interval:  1,
 	})
+	require.NoError(t, err)
 
 	resp, err := service.DeleteWithHeaders(context.Background(), "test", nil, nil)
 
@@ -601,3 +615,622 @@ func (c *customTransport) RoundTrip(r *http.Request) (*http.Response, error) {
 
 	return nil, testutil.CustomError{ErrorMessage: "cb error"}
 }
+
+func TestCircuitBreaker_DrainOnShutdown(t *testing.T) {
+	var healthChecks atomic.Int64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/.well-known/alive" {
+			healthChecks.Add(1)
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	before := BackgroundGoroutines()
+
+	svc, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), nil,
+		&CircuitBreakerConfig{Threshold: 1, Interval: time.Hour},
+		&CircuitBreakerConfig{Threshold: 1, Interval: time.Millisecond, DrainOnShutdown: true},
+		&BasicAuthConfig{UserName: "user", Password: "cGFzc3dvcmQ="},
+	)
+	require.NoError(t, err)
+
+	defer Close(svc)
+
+	// only the circuit breaker configured with DrainOnShutdown is found through the other options
+	breakers := CircuitBreakersToDrain(svc)
+	require.Len(t, breakers, 1)
+
+	resp, err := svc.Get(context.Background(), "test", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	_ = resp.Body.Close()
+
+	breakers[0].Drain()
+
+	resp, err = svc.Get(context.Background(), "test", nil)
+
+	assert.Equal(t, ErrCircuitOpen, err)
+	assert.Nil(t, resp)
+
+	// a draining circuit breaker must not recover even when the health check succeeds
+	assert.False(t, breakers[0].recoverCircuit())
+	assert.False(t, breakers[0].tryCircuitRecovery())
+	assert.Equal(t, OpenState, breakers[0].Stats().State)
+
+	// nor keep reaching the service with health checks, periodic or made by requests
+	assert.True(t, testutil.WaitForGoroutines(BackgroundGoroutines, before+1, 10*time.Second), "health checks leaked")
+
+	checks := healthChecks.Load()
+
+	time.Sleep(10 * time.Millisecond)
+
+	_, err = svc.Get(context.Background(), "test", nil)
+
+	assert.Equal(t, ErrCircuitOpen, err)
+	assert.Equal(t, checks, healthChecks.Load())
+}
+
+func TestCircuitBreakersToDrain_NoCircuitBreaker(t *testing.T) {
+	svc, err := NewHTTPService("http://localhost", nil, nil)
+	require.NoError(t, err)
+
+	assert.Empty(t, CircuitBreakersToDrain(svc))
+}
+
+func TestCircuitBreaker_AbsoluteURLOverride(t *testing.T) {
+	server := testServer()
+	defer server.Close()
+
+	otherServer := testServer()
+	defer otherServer.Close()
+
+	svc, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), nil, &CircuitBreakerConfig{
+		Threshold: 1,
+		Interval:  time.Hour,
+	})
+	require.NoError(t, err)
+
+	cb := svc.(*CircuitBreaker)
+	defer cb.Close()
+
+	cb.mu.Lock()
+	cb.openCircuit()
+	cb.mu.Unlock()
+
+	// requests to the service host are still guarded by the open circuit
+	resp, err := svc.Get(context.Background(), server.URL+"/test", nil)
+
+	assert.Equal(t, ErrCircuitOpen, err)
+	assert.Nil(t, resp)
+
+	// requests to another host are not blocked by the circuit of the service host
+	resp, err = svc.Get(context.Background(), otherServer.URL+"/test", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	_ = resp.Body.Close()
+}
+
+func TestCircuitBreaker_OtherHostCircuit(t *testing.T) {
+	server := testServer()
+	defer server.Close()
+
+	otherServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/fail" {
+			w.Header().Set("X-Degraded", "true")
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer otherServer.Close()
+
+	svc, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), nil, &CircuitBreakerConfig{
+		Threshold:      1,
+		Interval:       time.Hour,
+		DegradedHeader: "X-Degraded",
+	})
+	require.NoError(t, err)
+
+	cb := svc.(*CircuitBreaker)
+	defer cb.Close()
+
+	now := time.Now()
+	cb.now = func() time.Time { return now }
+
+	get := func(path string) (int, error) {
+		resp, err := svc.Get(context.Background(), path, nil)
+		if err != nil {
+			return 0, err
+		}
+
+		_ = resp.Body.Close()
+
+		return resp.StatusCode, nil
+	}
+
+	// the failure that crosses the threshold opens the circuit of the other host only
+	_, err = get(otherServer.URL + "/fail")
+	require.NoError(t, err)
+
+	_, err = get(otherServer.URL + "/fail")
+	assert.Equal(t, ErrCircuitOpen, err)
+
+	_, err = get(otherServer.URL + "/success")
+	assert.Equal(t, ErrCircuitOpen, err)
+
+	status, err := get("success")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, ClosedState, cb.Stats().State)
+
+	// after the interval a failed trial request returns its own response and keeps the circuit open
+	now = now.Add(time.Hour + time.Second)
+
+	status, err = get(otherServer.URL + "/fail")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+
+	_, err = get(otherServer.URL + "/success")
+	assert.Equal(t, ErrCircuitOpen, err)
+
+	// a successful trial request closes the circuit of the other host
+	now = now.Add(time.Hour + time.Second)
+
+	_, err = get(otherServer.URL + "/success")
+	require.NoError(t, err)
+
+	_, err = get(otherServer.URL + "/success")
+	require.NoError(t, err)
+
+	cb.mu.RLock()
+	assert.Empty(t, cb.hosts)
+	cb.mu.RUnlock()
+}
+
+func TestCircuitBreaker_Close(t *testing.T) {
+	server := testServer()
+	defer server.Close()
+
+	before := BackgroundGoroutines()
+
+	svc, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), nil, &CircuitBreakerConfig{
+		Threshold: 1,
+		Interval:  time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	cb := svc.(*CircuitBreaker)
+
+	// every circuit breaker runs its health checks in a single goroutine
+	assert.Equal(t, before+1, BackgroundGoroutines())
+
+	cb.mu.Lock()
+	cb.openCircuit()
+	cb.mu.Unlock()
+
+	assert.Eventually(t, func() bool { return cb.Stats().State == ClosedState }, 10*time.Second, time.Millisecond,
+		"the periodic health check did not close the circuit")
+
+	cb.Close()
+	cb.Close()
+
+	assert.True(t, testutil.WaitForGoroutines(BackgroundGoroutines, before, 10*time.Second), "health checks leaked")
+}
+
+func TestCircuitBreaker_StatsDuringSlowRequest(t *testing.T) {
+	started, release := make(chan struct{}), make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			close(started)
+			<-release
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	svc, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), nil, &CircuitBreakerConfig{
+		Threshold: 1,
+		Interval:  time.Hour,
+	})
+	require.NoError(t, err)
+
+	cb := svc.(*CircuitBreaker)
+	defer cb.Close()
+
+	done := make(chan error, 1)
+
+	go func() {
+		resp, err := svc.Get(context.Background(), "slow", nil)
+		if err == nil {
+			_ = resp.Body.Close()
+		}
+
+		done <- err
+	}()
+
+	<-started
+
+	// neither Stats nor other requests wait for the request blocked upstream
+	stats := make(chan CircuitBreakerStats, 1)
+
+	go func() {
+		stats <- cb.Stats()
+	}()
+
+	select {
+	case s := <-stats:
+		assert.Equal(t, ClosedState, s.State)
+	case <-time.After(time.Second):
+		t.Error("Stats blocked by the in-flight request")
+	}
+
+	resp, err := svc.Get(context.Background(), "fast", nil)
+	require.NoError(t, err)
+
+	_ = resp.Body.Close()
+
+	close(release)
+
+	require.NoError(t, <-done)
+}
+
+func TestCircuitBreaker_SingleTrialRequest(t *testing.T) {
+	started, release := make(chan struct{}), make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			close(started)
+			<-release
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	svc, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), nil, &CircuitBreakerConfig{
+		Threshold:       1,
+		Interval:        time.Hour,
+		RecoverySpacing: time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	cb := svc.(*CircuitBreaker)
+	defer cb.Close()
+
+	cb.mu.Lock()
+	cb.openCircuit()
+	cb.resetCircuit()
+	cb.mu.Unlock()
+
+	done := make(chan error, 1)
+
+	go func() {
+		resp, err := svc.Get(context.Background(), "slow", nil)
+		if err == nil {
+			_ = resp.Body.Close()
+		}
+
+		done <- err
+	}()
+
+	<-started
+
+	// other requests fail fast while the trial request is in flight
+	_, err = svc.Get(context.Background(), "fast", nil)
+	assert.Equal(t, ErrCircuitOpen, err)
+
+	close(release)
+
+	require.NoError(t, <-done)
+	assert.Equal(t, 1, cb.Stats().RecoveryProbe)
+}
+
+func TestClose(t *testing.T) {
+	before := BackgroundGoroutines()
+
+	svc, err := NewHTTPService("http://localhost", testutil.NewMockLogger(testutil.DEBUGLOG), nil,
+		&CircuitBreakerConfig{Threshold: 1, Interval: time.Hour}, &BasicAuthConfig{UserName: "user"},
+		&CircuitBreakerConfig{Threshold: 2, Interval: time.Hour})
+	require.NoError(t, err)
+
+	assert.Equal(t, before+2, BackgroundGoroutines())
+
+	Close(svc)
+
+	assert.True(t, testutil.WaitForGoroutines(BackgroundGoroutines, before, 10*time.Second), "health checks leaked")
+}
+
+func TestCircuitBreaker_hasDegradedSignal(t *testing.T) {
+	tests := []struct {
+		desc   string
+		value  string
+		header http.Header
+		exp    bool
+	}{
+		{"header is missing", "true", http.Header{}, false},
+		{"value matches", "true", http.Header{"X-Degraded": {"TRUE"}}, true},
+		{"value does not match", "true", http.Header{"X-Degraded": {"false"}}, false},
+		{"repeated header with a matching value", "true", http.Header{"X-Degraded": {"false", "true"}}, true},
+		{"presence of the header alone", "", http.Header{"X-Degraded": {""}}, true},
+	}
+
+	for i, tc := range tests {
+		cb := &CircuitBreaker{degradedHeader: "x-degraded", degradedValue: tc.value}
+
+		assert.Equal(t, tc.exp, cb.hasDegradedSignal(&http.Response{Header: tc.header}), "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestCircuitBreaker_DegradedHeader(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/degraded" {
+			w.Header().Set("X-Degraded", "TRUE")
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	svc, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), nil, &CircuitBreakerConfig{
+		Threshold:      1,
+		Interval:       time.Hour,
+		DegradedHeader: "x-degraded",
+		DegradedValue:  "true",
+	})
+	require.NoError(t, err)
+
+	cb := svc.(*CircuitBreaker)
+	defer cb.Close()
+
+	// a degraded response is still returned to the caller
+	resp, err := svc.Get(context.Background(), "degraded", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	_ = resp.Body.Close()
+
+	assert.Equal(t, CircuitBreakerStats{State: ClosedState, FailureCount: 1, Degraded: true, DegradedResponses: 1},
+		cb.Stats())
+
+	// a healthy response resets the failure count
+	resp, err = svc.Get(context.Background(), "healthy", nil)
+
+	assert.Nil(t, err)
+
+	_ = resp.Body.Close()
+
+	stats := cb.Stats()
+
+	assert.False(t, stats.LastSuccess.IsZero())
+
+	stats.LastSuccess = time.Time{}
+
+	assert.Equal(t, CircuitBreakerStats{State: ClosedState, DegradedResponses: 1}, stats)
+
+	// consecutive degraded responses open the circuit, the one crossing the threshold is replaced by ErrCircuitOpen
+	for i := 0; i < 2; i++ {
+		resp, err = svc.Get(context.Background(), "degraded", nil)
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+	}
+
+	assert.Equal(t, ErrCircuitOpen, err)
+	assert.Nil(t, resp)
+
+	stats = cb.Stats()
+
+	assert.Equal(t, OpenState, stats.State)
+	assert.True(t, stats.Degraded)
+	assert.Equal(t, 3, stats.DegradedResponses)
+}
+
+func TestCircuitBreaker_RecoverySpacing(t *testing.T) {
+	server := testServer()
+	defer server.Close()
+
+	svc := &httpService{
+		Client: &http.Client{Transport: &customTransport{}},
+		url:    server.URL,
+		Tracer: otel.Tracer("gofr-http-client"),
+		Logger: testutil.NewMockLogger(testutil.DEBUGLOG),
+	}
+
+	cb := NewCircuitBreaker(CircuitBreakerConfig{
+		Threshold:       1,
+		Interval:        time.Minute,
+		RecoverySpacing: time.Second,
+		RecoveryProbes:  3,
+	}, svc)
+	defer cb.Close()
+
+	now := time.Now()
+	cb.now = func() time.Time { return now }
+
+	get := func(path string) error {
+		resp, err := cb.Get(context.Background(), path, nil)
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+
+		return err
+	}
+
+	// open the circuit and let the health check recover it
+	_ = get("fail")
+	_ = get("fail")
+	assert.Equal(t, OpenState, cb.Stats().State)
+
+	now = now.Add(2 * time.Minute)
+
+	steps := []struct {
+		desc       string
+		advance    time.Duration
+		expErr     error
+		expState   int
+		expProbe   int
+		expNextDue time.Duration
+	}{
+		{"first trial request is admitted immediately", 0, nil, RecoveringState, 1, time.Second},
+		{"request before next probe fails fast", 0, ErrCircuitOpen, RecoveringState, 1, time.Second},
+		{"second trial request after spacing", time.Second, nil, RecoveringState, 2, 2 * time.Second},
+		{"spacing doubles after success", time.Second, ErrCircuitOpen, RecoveringState, 2, time.Second},
+		{"third trial request closes the circuit", time.Second, nil, ClosedState, 0, 0},
+	}
+
+	for i, tc := range steps {
+		now = now.Add(tc.advance)
+
+		err := get("success")
+		stats := cb.Stats()
+
+		assert.Equal(t, tc.expErr, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.expState, stats.State, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.expProbe, stats.RecoveryProbe, "TEST[%d], Failed.\n%s", i, tc.desc)
+
+		if tc.expNextDue > 0 {
+			assert.Equal(t, now.Add(tc.expNextDue), stats.NextProbe, "TEST[%d], Failed.\n%s", i, tc.desc)
+		} else {
+			assert.True(t, stats.NextProbe.IsZero(), "TEST[%d], Failed.\n%s", i, tc.desc)
+		}
+	}
+
+	// a failed trial request opens the circuit again and returns its own error
+	_ = get("fail")
+	_ = get("fail")
+
+	now = now.Add(2 * time.Minute)
+
+	assert.ErrorContains(t, get("fail"), "cb error")
+	assert.Equal(t, OpenState, cb.Stats().State)
+	assert.Equal(t, ErrCircuitOpen, get("success"))
+}
+
+func TestCircuitBreaker_LastSuccess(t *testing.T) {
+	server := testServer()
+	defer server.Close()
+
+	svc := &httpService{
+		Client: &http.Client{Transport: &customTransport{}},
+		url:    server.URL,
+		Tracer: otel.Tracer("gofr-http-client"),
+		Logger: testutil.NewMockLogger(testutil.DEBUGLOG),
+	}
+
+	cb := NewCircuitBreaker(CircuitBreakerConfig{Threshold: 1, Interval: time.Minute}, svc)
+	defer cb.Close()
+
+	start := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	now := start
+	cb.now = func() time.Time { return now }
+
+	assert.True(t, cb.Stats().LastSuccess.IsZero())
+	assert.Contains(t, cb.Stats().String(), "lastSuccess=never")
+
+	resp, err := cb.Get(context.Background(), "success", nil)
+
+	assert.Nil(t, err)
+
+	_ = resp.Body.Close()
+
+	// failed requests do not change the time of the last success
+	now = now.Add(time.Second)
+	_, _ = cb.Get(context.Background(), "fail", nil)
+	_, _ = cb.Get(context.Background(), "fail", nil)
+
+	assert.Equal(t, start, cb.Stats().LastSuccess)
+	assert.Equal(t, "state=open failures=2 lastChecked=2024-03-01T10:00:01Z lastSuccess=2024-03-01T10:00:00Z "+
+		"degraded=false degradedResponses=0", cb.Stats().String())
+
+	// a successful health check while recovering is recorded as well
+	now = now.Add(2 * time.Minute)
+
+	assert.True(t, cb.tryCircuitRecovery())
+	assert.Equal(t, now, cb.Stats().LastSuccess)
+}
+
+func TestCircuitBreaker_ConcurrentRecovery(t *testing.T) {
+	server := testServer()
+	defer server.Close()
+
+	svc, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.DEBUGLOG), nil)
+	require.NoError(t, err)
+
+	cb := NewCircuitBreaker(CircuitBreakerConfig{
+		Threshold:       1,
+		Interval:        time.Millisecond,
+		RecoverySpacing: time.Millisecond,
+	}, svc)
+	defer cb.Close()
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 20; j++ {
+				cb.mu.Lock()
+				cb.openCircuit()
+				cb.mu.Unlock()
+
+				// the circuit recovers through requests and the periodic health checks concurrently
+				resp, _ := cb.Get(context.Background(), "test", nil)
+				if resp != nil {
+					_ = resp.Body.Close()
+				}
+
+				_ = cb.Stats()
+
+				time.Sleep(time.Millisecond)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	// no trial request is left in flight, so requests to the healthy service close the circuit again
+	assert.Eventually(t, func() bool {
+		resp, err := cb.Get(context.Background(), "test", nil)
+		if err == nil && resp != nil {
+			_ = resp.Body.Close()
+		}
+
+		return cb.Stats().State == ClosedState
+	}, 10*time.Second, time.Millisecond)
+
+	stats := cb.Stats()
+
+	assert.Zero(t, stats.FailureCount)
+	assert.Zero(t, stats.RecoveryProbe)
+	assert.False(t, stats.LastSuccess.IsZero())
+}
+
+func Test_recoveryBackoff(t *testing.T) {
+	tests := []struct {
+		desc       string
+		spacing    time.Duration
+		probe      int
+		expBackoff time.Duration
+	}{
+		{"first trial", time.Second, 1, time.Second},
+		{"doubles after every trial", time.Second, 4, 8 * time.Second},
+		{"capped instead of overflowing", time.Second, 40, maxRecoveryBackoff},
+		{"spacing above the cap is kept", 2 * time.Hour, 5, 2 * time.Hour},
+	}
+
+	for i, tc := range tests {
+		assert.Equal(t, tc.expBackoff, recoveryBackoff(tc.spacing, tc.probe), "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
@@ -0,0 +1,34 @@
+package service
+
+// CircuitBreakersToDrain returns the circuit breakers wrapping h that are configured with DrainOnShutdown.
+func CircuitBreakersToDrain(h HTTP) []*CircuitBreaker {
+	var breakers []*CircuitBreaker
+
+	for _, cb := range h.circuitBreakers() {
+		if cb.drainOnShutdown {
+			breakers = append(breakers, cb)
+		}
+	}
+
+	return breakers
+}
+
+// Drain transitions the circuit breaker to the open state and stops it from recovering, so that all further
+// requests fail fast with ErrCircuitOpen. It also stops the health checks, which would keep reaching the service.
+func (cb *CircuitBreaker) Drain() {
+	cb.mu.Lock()
+	cb.draining = true
+	cb.openCircuit()
+	cb.mu.Unlock()
+
+	cb.Close()
+}
+
+// circuitBreakers returns the circuit breakers in the chain of options wrapping the service, outermost last.
+func (cb *CircuitBreaker) circuitBreakers() []*CircuitBreaker {
+	return append(cb.HTTP.circuitBreakers(), cb)
+}
+
+func (h *httpService) circuitBreakers() []*CircuitBreaker {
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	"gofr.dev/pkg/gofr/testutil"
@@ -47,7 +48,8 @@ func TestHTTPService_HealthCheckCustomURL(t *testing.T) {
 }
 
 func TestHTTPService_HealthCheckErrorResponse(t *testing.T) {
-	service := NewHTTPService("http://test", testutil.NewMockLogger(testutil.INFOLOG), nil)
+	service, err := NewHTTPService("http://test", testutil.NewMockLogger(testutil.INFOLOG), nil)
+	require.NoError(t, err)
 
 	ctx := context.Background()
 
@@ -89,8 +91,9 @@ func initializeTest(t *testing.T, urlSuffix string, statusCode int) (HTTP, *http
 		w.WriteHeader(statusCode)
 	}))
 
-	service := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.INFOLOG), metrics,
+	service, err := NewHTTPService(server.URL, testutil.NewMockLogger(testutil.INFOLOG), metrics,
 		&HealthConfig{HealthEndpoint: ".well-known/" + urlSuffix})
+	require.NoError(t, err)
 
 	return service, server, metrics
 }
@@ -3,9 +3,11 @@ package service
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptrace"
+	"net/url"
 	"strings"
 	"time"
 
@@ -15,6 +17,9 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
+// ErrInvalidServiceAddress is returned by NewHTTPService when the service address is not an absolute HTTP(S) URL.
+var ErrInvalidServiceAddress = errors.New("invalid service address")
+
 type httpService struct {
 	*http.Client
 	trace.Tracer
@@ -30,8 +35,12 @@ type HTTP interface {
 	// HealthCheck to get the service health and report it to the current application
 	HealthCheck(ctx context.Context) *Health
 	getHealthResponseForEndpoint(ctx context.Context, endpoint string) *Health
+	baseURL() string
+	circuitBreakers() []*CircuitBreaker
 }
 
+// httpClient methods accept either a path relative to the service address or an absolute http(s) URL. An absolute
+// URL takes precedence over the service address and is used as-is, e.g. to follow a Location header.
 type httpClient interface {
 	// Get performs an HTTP GET request.
 	Get(ctx context.Context, api string, queryParams map[string]interface{}) (*http.Response, error)
@@ -65,11 +74,18 @@ type httpClient interface {
 
 // NewHTTPService function creates a new instance of the httpService struct, which implements the HTTP interface.
 // It initializes the http.Client, url, Tracer, and Logger fields of the httpService struct with the provided values.
-func NewHTTPService(serviceAddress string, logger Logger, metrics Metrics, options ...Options) HTTP {
+// The service address must be an absolute http or https URL, any trailing slash is removed so that request
+// paths are always joined to it with a single slash.
+func NewHTTPService(serviceAddress string, logger Logger, metrics Metrics, options ...Options) (HTTP, error) {
+	address, err := normalizeServiceAddress(serviceAddress)
+	if err != nil {
+		return nil, err
+	}
+
 	h := &httpService{
 		// using default http client to do http communication
 		Client:  &http.Client{},
-		url:     serviceAddress,
+		url:     address,
 		Tracer:  otel.Tracer("gofr-http-client"),
 		Logger:  logger,
 		Metrics: metrics,
@@ -83,7 +99,25 @@ func NewHTTPService(serviceAddress string, logger Logger, metrics Metrics, optio
 		svc = o.addOption(svc)
 	}
 
-	return svc
+	return svc, nil
+}
+
+// normalizeServiceAddress validates that the address has an http(s) scheme and a host, and strips trailing slashes.
+func normalizeServiceAddress(address string) (string, error) {
+	u, err := url.Parse(address)
+	if err != nil {
+		return "", fmt.Errorf("%w %q: %v", ErrInvalidServiceAddress, address, err)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", fmt.Errorf("%w %q: scheme must be http or https", ErrInvalidServiceAddress, address)
+	}
+
+	if u.Host == "" {
+		return "", fmt.Errorf("%w %q: host is missing", ErrInvalidServiceAddress, address)
+	}
+
+	return strings.TrimRight(address, "/"), nil
 }
 
 func (h *httpService) Get(ctx context.Context, path string, queryParams map[string]interface{}) (*http.Response, error) {
@@ -134,7 +168,11 @@ func (h *httpService) DeleteWithHeaders(ctx context.Context, path string, body [
 
 func (h *httpService) createAndSendRequest(ctx context.Context, method string, path string,
 	queryParams map[string]interface{}, body []byte, headers map[string]string) (*http.Response, error) {
-	uri := h.url + "/" + path
+	uri := h.url + "/" + strings.TrimLeft(path, "/")
+	if _, ok := absoluteURL(path); ok {
+		uri = path
+	}
+
 	uri = strings.TrimRight(uri, "/")
 
 	spanContext, span := h.Tracer.Start(ctx, uri)
@@ -191,6 +229,37 @@ func (h *httpService) createAndSendRequest(ctx context.Context, method string, p
 	return resp, nil
 }
 
+func (h *httpService) baseURL() string {
+	return h.url
+}
+
+// absoluteURL reports whether path is an absolute http(s) URL that overrides the service address.
+func absoluteURL(path string) (*url.URL, bool) {
+	u, err := url.Parse(path)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, false
+	}
+
+	return u, true
+}
+
+// isOtherHost reports whether path is an absolute URL whose origin, i.e. scheme and host, differs from the origin of
+// the service address. A plain http URL to the host of an https service is another origin, so that credentials are
+// not sent unencrypted.
+func isOtherHost(h HTTP, path string) bool {
+	u, ok := absoluteURL(path)
+	if !ok {
+		return false
+	}
+
+	base, err := url.Parse(h.baseURL())
+	if err != nil {
+		return true
+	}
+
+	return !strings.EqualFold(u.Scheme, base.Scheme) || !strings.EqualFold(u.Host, base.Host)
+}
+
 // HealthCheck default healthcheck for HTTP Service.
 
 func encodeQueryParameters(req *http.Request, queryParams map[string]interface{}) {
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel"
 	"go.uber.org/mock/gomock"
 
@@ -18,20 +19,77 @@ func TestNewHTTPService(t *testing.T) {
 	tests := []struct {
 		desc           string
 		serviceAddress string
+		expURL         string
+		expErr         bool
 	}{
-		{"Valid Address", "http://example.com"},
-		{"Empty Address", ""},
-		{"Invalid Address", "not_a_valid_address"},
+		{"Valid Address", "http://example.com", "http://example.com", false},
+		{"Trailing Slash", "https://example.com/api/", "https://example.com/api", false},
+		{"Empty Address", "", "", true},
+		{"Invalid Address", "not_a_valid_address", "", true},
+		{"Missing Scheme", "localhost:8080", "", true},
+		{"Missing Host", "http://", "", true},
+		{"Unsupported Scheme", "ftp://example.com", "", true},
+		{"Unparsable Address", "http://exa mple.com", "", true},
 	}
 
 	for i, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
-			service := NewHTTPService(tc.serviceAddress, nil, nil)
-			assert.NotNil(t, service, "TEST[%d], Failed.\n%s", i, tc.desc)
+			service, err := NewHTTPService(tc.serviceAddress, nil, nil)
+
+			if tc.expErr {
+				assert.ErrorIs(t, err, ErrInvalidServiceAddress, "TEST[%d], Failed.\n%s", i, tc.desc)
+				assert.Nil(t, service, "TEST[%d], Failed.\n%s", i, tc.desc)
+
+				return
+			}
+
+			assert.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+			assert.Equal(t, tc.expURL, service.(*httpService).url, "TEST[%d], Failed.\n%s", i, tc.desc)
 		})
 	}
 }
 
+func TestHTTPService_PathJoining(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/users", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	service, err := NewHTTPService(server.URL+"/api/", testutil.NewMockLogger(testutil.INFOLOG), nil)
+	assert.NoError(t, err)
+
+	for _, path := range []string{"users", "/users", "users/"} {
+		resp, err := service.Get(context.Background(), path, nil)
+
+		assert.NoError(t, err, path)
+		assert.Equal(t, http.StatusOK, resp.StatusCode, path)
+
+		_ = resp.Body.Close()
+	}
+}
+
+func TestHTTPService_AbsoluteURLOverride(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/regional/users", r.URL.Path)
+		assert.Equal(t, "gofr", r.URL.Query().Get("name"))
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	service, err := NewHTTPService("http://example.com/api", testutil.NewMockLogger(testutil.INFOLOG), nil)
+	require.NoError(t, err)
+
+	resp, err := service.Get(context.Background(), server.URL+"/regional/users", map[string]interface{}{"name": "gofr"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	_ = resp.Body.Close()
+}
+
 func TestHTTPService_createAndSendRequest(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
@@ -50,9 +50,14 @@ type oAuth struct {
 	HTTP
 }
 
-func (o *oAuth) addAuthorizationHeader(ctx context.Context, headers map[string]string) (map[string]string, error) {
+// addAuthorizationHeader sets the access token unless the request is sent to another host than the service address.
+func (o *oAuth) addAuthorizationHeader(ctx context.Context, path string, headers map[string]string) (map[string]string, error) {
 	var err error
 
+	if isOtherHost(o, path) {
+		return headers, nil
+	}
+
 	if headers == nil {
 		headers = make(map[string]string)
 	}
@@ -69,7 +74,7 @@ func (o *oAuth) addAuthorizationHeader(ctx context.Context, headers map[string]s
 
 func (o *oAuth) GetWithHeaders(ctx context.Context, path string, queryParams map[string]interface{},
 	headers map[string]string) (*http.Response, error) {
-	headers, err := o.addAuthorizationHeader(ctx, headers)
+	headers, err := o.addAuthorizationHeader(ctx, path, headers)
 	if err != nil {
 		return nil, err
 	}
@@ -80,7 +85,7 @@ func (o *oAuth) GetWithHeaders(ctx context.Context, path string, queryParams map
 // PostWithHeaders is a wrapper for doRequest with the POST method and headers.
 func (o *oAuth) PostWithHeaders(ctx context.Context, path string, queryParams map[string]interface{},
 	body []byte, headers map[string]string) (*http.Response, error) {
-	headers, err := o.addAuthorizationHeader(ctx, headers)
+	headers, err := o.addAuthorizationHeader(ctx, path, headers)
 	if err != nil {
 		return nil, err
 	}
@@ -91,7 +96,7 @@ func (o *oAuth) PostWithHeaders(ctx context.Context, path string, queryParams ma
 // PatchWithHeaders is a wrapper for doRequest with the PATCH method and headers.
 func (o *oAuth) PatchWithHeaders(ctx context.Context, path string, queryParams map[string]interface{},
 	body []byte, headers map[string]string) (*http.Response, error) {
-	headers, err := o.addAuthorizationHeader(ctx, headers)
+	headers, err := o.addAuthorizationHeader(ctx, path, headers)
 	if err != nil {
 		return nil, err
 	}
@@ -102,7 +107,7 @@ func (o *oAuth) PatchWithHeaders(ctx context.Context, path string, queryParams m
 // PutWithHeaders is a wrapper for doRequest with the PUT method and headers.
 func (o *oAuth) PutWithHeaders(ctx context.Context, path string, queryParams map[string]interface{},
 	body []byte, headers map[string]string) (*http.Response, error) {
-	headers, err := o.addAuthorizationHeader(ctx, headers)
+	headers, err := o.addAuthorizationHeader(ctx, path, headers)
 	if err != nil {
 		return nil, err
 	}
@@ -113,7 +118,7 @@ func (o *oAuth) PutWithHeaders(ctx context.Context, path string, queryParams map
 // DeleteWithHeaders is a wrapper for doRequest with the DELETE method and headers.
 func (o *oAuth) DeleteWithHeaders(ctx context.Context, path string, body []byte, headers map[string]string) (
 	*http.Response, error) {
-	headers, err := o.addAuthorizationHeader(ctx, headers)
+	headers, err := o.addAuthorizationHeader(ctx, path, headers)
 	if err != nil {
 		return nil, err
 	}
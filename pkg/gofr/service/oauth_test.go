@@ -205,3 +205,31 @@ func TestHttpService_GetRequestsOAuthError(t *testing.T) {
 		resp.Body.Close()
 	}
 }
+
+func TestHttpService_OtherHostWithoutToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// the token endpoint is unreachable, so a request fetching a token would fail
+	httpSvc := (&OAuthConfig{TokenURL: "http://localhost:0/token"}).addOption(&httpService{
+		Client: &http.Client{},
+		url:    "http://example.com",
+		Tracer: otel.Tracer("gofr-http-client"),
+		Logger: testutil.NewMockLogger(testutil.DEBUGLOG),
+	})
+
+	resp, err := httpSvc.Get(context.Background(), server.URL+"/test", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	_ = resp.Body.Close()
+
+	_, err = httpSvc.Get(context.Background(), "test", nil)
+
+	assert.NotNil(t, err)
+}
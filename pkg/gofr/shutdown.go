@@ -0,0 +1,76 @@
+package gofr
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"gofr.dev/pkg/gofr/service"
+)
+
+// shutdownTimeout bounds how long a graceful shutdown waits for in-flight requests to complete.
+const shutdownTimeout = 30 * time.Second
+
+// handleShutdown installs a graceful shutdown on SIGINT and SIGTERM when any HTTP service has a circuit breaker
+// configured with DrainOnShutdown. It returns a channel that is closed once the application has shut down, or nil
+// when no circuit breaker opted in, in which case signals keep their default behavior.
+func (a *App) handleShutdown() <-chan struct{} {
+	var breakers []*service.CircuitBreaker
+
+	for _, svc := range a.container.Services {
+		breakers = append(breakers, service.CircuitBreakersToDrain(svc)...)
+	}
+
+	if len(breakers) == 0 {
+		return nil
+	}
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+
+	stopped := make(chan struct{})
+
+	go func() {
+		s := <-sig
+
+		// a second signal terminates the application immediately
+		signal.Stop(sig)
+
+		a.container.Infof("Received %v, shutting down gracefully", s)
+
+		a.shutdown(breakers)
+		close(stopped)
+	}()
+
+	return stopped
+}
+
+// shutdown opens the circuit breakers and then stops the servers, waiting for in-flight requests to complete, so that
+// their calls to services that may be terminating as well fail fast with service.ErrCircuitOpen. Finally, it stops
+// the background goroutines of the container.
+func (a *App) shutdown(breakers []*service.CircuitBreaker) {
+	for _, cb := range breakers {
+		cb.Drain()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if a.httpRegistered {
+		if err := a.httpServer.Shutdown(ctx); err != nil {
+			a.container.Errorf("error while shutting down http server: %v", err)
+		}
+	}
+
+	if a.grpcRegistered {
+		a.grpcServer.Shutdown(ctx)
+	}
+
+	if err := a.metricServer.Shutdown(ctx, a.container); err != nil {
+		a.container.Errorf("error while shutting down metrics server: %v", err)
+	}
+
+	a.container.Close()
+}
@@ -0,0 +1,131 @@
+package gofr
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gofr.dev/pkg/gofr/service"
+	"gofr.dev/pkg/gofr/testutil"
+)
+
+func TestApp_ShutdownDrainsCircuitBreakers(t *testing.T) {
+	t.Setenv("HTTP_PORT", "8013")
+	t.Setenv("METRICS_PORT", "2123")
+
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer upstream.Close()
+
+	before := service.BackgroundGoroutines()
+
+	app := New()
+
+	app.AddHTTPService("upstream", upstream.URL, &service.CircuitBreakerConfig{
+		Threshold:       1,
+		Interval:        time.Hour,
+		DrainOnShutdown: true,
+	})
+
+	started, release := make(chan struct{}), make(chan struct{})
+
+	app.GET("/call", func(c *Context) (interface{}, error) {
+		close(started)
+		<-release
+
+		resp, err := c.GetHTTPService("upstream").Get(c, "", nil)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Body.Close()
+
+		return "reached upstream", nil
+	})
+
+	stopped := make(chan struct{})
+
+	go func() {
+		app.Run()
+		close(stopped)
+	}()
+
+	// the signal handler is installed before the servers start, so the signal below cannot terminate the test
+	require.Eventually(t, func() bool {
+		resp, err := http.Get("http://localhost:8013/.well-known/alive") //nolint:noctx // test request
+		if err != nil {
+			return false
+		}
+
+		resp.Body.Close()
+
+		return resp.StatusCode == http.StatusOK
+	}, 5*time.Second, 10*time.Millisecond)
+
+	body := make(chan string, 1)
+
+	go func() {
+		resp, err := http.Get("http://localhost:8013/call") //nolint:noctx // test request
+		if err != nil {
+			body <- err.Error()
+			return
+		}
+
+		defer resp.Body.Close()
+
+		b, _ := io.ReadAll(resp.Body)
+		body <- string(b)
+	}()
+
+	<-started
+
+	p, err := os.FindProcess(os.Getpid())
+	require.NoError(t, err)
+	require.NoError(t, p.Signal(syscall.SIGTERM))
+
+	cb := app.container.Services["upstream"].(*service.CircuitBreaker)
+
+	require.Eventually(t, func() bool { return cb.Stats().State == service.OpenState }, 5*time.Second,
+		10*time.Millisecond)
+
+	// the in-flight request completes during the shutdown, failing fast on the drained circuit breaker
+	close(release)
+
+	assert.Contains(t, <-body, service.ErrCircuitOpen.Error())
+
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("application did not stop after the shutdown signal")
+	}
+
+	assert.True(t, testutil.WaitForGoroutines(service.BackgroundGoroutines, before, 5*time.Second),
+		"circuit breaker health checks leaked")
+}
+
+func TestApp_NoShutdownHookWithoutDraining(t *testing.T) {
+	app := New()
+
+	app.AddHTTPService("upstream", "http://localhost", &service.CircuitBreakerConfig{Threshold: 1, Interval: time.Hour})
+
+	assert.Nil(t, app.handleShutdown())
+}
+
+func TestApp_shutdownBeforeRun(t *testing.T) {
+	logs := testutil.StderrOutputForFunc(func() {
+		app := New()
+		app.httpRegistered = true
+
+		app.shutdown(nil)
+	})
+
+	assert.NotContains(t, logs, "error while shutting down")
+}
@@ -0,0 +1,21 @@
+package testutil
+
+import "time"
+
+// WaitForGoroutines polls count, e.g. service.BackgroundGoroutines, until it returns expected or the timeout elapses.
+// It reports whether the expected number of goroutines was reached, which allows tests to detect leaked goroutines.
+func WaitForGoroutines(count func() int64, expected int64, timeout time.Duration) bool {
+	const pollInterval = 10 * time.Millisecond
+
+	deadline := time.Now().Add(timeout)
+
+	for count() != expected {
+		if time.Now().After(deadline) {
+			return false
+		}
+
+		time.Sleep(pollInterval)
+	}
+
+	return true
+}
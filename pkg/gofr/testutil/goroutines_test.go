@@ -0,0 +1,26 @@
+package testutil
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWaitForGoroutines(t *testing.T) {
+	var active atomic.Int64
+
+	active.Store(1)
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		active.Add(-1)
+	}()
+
+	if !WaitForGoroutines(active.Load, 0, time.Second) {
+		t.Errorf("Expected the goroutine count to reach 0, got %d", active.Load())
+	}
+
+	if WaitForGoroutines(active.Load, 1, 50*time.Millisecond) {
+		t.Errorf("Expected the wait for an unreachable goroutine count to time out")
+	}
+}